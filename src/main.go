@@ -2,9 +2,11 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 	"te/search"
 	sh "te/search/http"
@@ -19,16 +21,30 @@ func main() {
 	flag.StringVar(&authtoken, "t", "", "The authtoken for non-query actions.")
 	flag.Parse()
 
-	s := search.NewPersistentSearchServer("./search_data")
+	s, err := search.NewPersistentSearchServer("./search_data")
+	if err != nil {
+		fmt.Println(err.Error())
+		// aliases of missing collections are dropped, other data must load
+		if !errors.Is(err, search.ErrCollectionNotFound) {
+			os.Exit(1)
+		}
+	}
 
-	// ensure any default collections exist
+	// ensure any default collections exist, saved collections already do
 	if collection != "" {
 		list := strings.Split(collection, ",")
 		for _, name := range list {
-			s.Create(name)
+			err := s.Create(name)
+			if errors.Is(err, search.ErrCollectionExists) {
+				if target, ok := s.Aliases()[name]; ok {
+					fmt.Printf("%v is an alias of %v, not a collection\n", name, target)
+				}
+			} else if err != nil {
+				fmt.Println(err.Error())
+			}
 		}
 	}
 
-	err := http.ListenAndServe(addr, sh.HandlerFunc(s, authtoken))
+	err = http.ListenAndServe(addr, sh.Handler(s, authtoken))
 	fmt.Println(err.Error())
 }
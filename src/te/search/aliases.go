@@ -0,0 +1,140 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// saved in the server's save path. Collection names can't contain a `.`, so
+// it can't clash with a collection directory
+const aliasesFileName string = "_aliases.json"
+
+// SetAlias points `alias` at `collection`, replacing what it pointed at
+// before. Queries and writes to the alias go to the collection. Returns
+// ErrInvalidName if the alias is not a valid collection name,
+// ErrCollectionExists if a collection has the same name, or
+// ErrCollectionNotFound if the collection does not exist. Aliases can't point
+// to other aliases.
+func (s *SearchServer) SetAlias(alias string, collection string) error {
+	if !isValidName(alias) {
+		return ErrInvalidName
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.searchEngines[alias]; ok {
+		return ErrCollectionExists
+	}
+	if _, ok := s.searchEngines[collection]; !ok {
+		return ErrCollectionNotFound
+	}
+
+	aliases := s.copyAliases()
+	aliases[alias] = collection
+	return s.setAliases(aliases)
+}
+
+// RemoveAlias deletes `alias`, the collection it points to is not changed.
+// Returns ErrAliasNotFound if the alias does not exist.
+func (s *SearchServer) RemoveAlias(alias string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.aliases[alias]; !ok {
+		return ErrAliasNotFound
+	}
+
+	aliases := s.copyAliases()
+	delete(aliases, alias)
+	return s.setAliases(aliases)
+}
+
+// Aliases returns the collection each alias points to, by alias
+func (s *SearchServer) Aliases() map[string]string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.copyAliases()
+}
+
+func (s *SearchServer) copyAliases() map[string]string {
+	aliases := make(map[string]string, len(s.aliases))
+	for k, v := range s.aliases {
+		aliases[k] = v
+	}
+	return aliases
+}
+
+// setAliases saves `aliases`, then replaces the current ones with them. The
+// aliases are left unchanged if they can't be saved.
+func (s *SearchServer) setAliases(aliases map[string]string) error {
+	if s.persistent {
+		if err := s.writeAliasesToDisk(aliases); err != nil {
+			return err
+		}
+	}
+	s.aliases = aliases
+	return nil
+}
+
+func (s *SearchServer) writeAliasesToDisk(aliases map[string]string) error {
+	path := filepath.Join(s.savePath, aliasesFileName)
+
+	bytes, err := json.Marshal(aliases)
+	if err != nil {
+		return &PersistenceError{Op: "encode aliases", Path: path, Err: err}
+	}
+
+	if err = os.MkdirAll(s.savePath, 0770); err != nil {
+		return &PersistenceError{Op: "create directory", Path: s.savePath, Err: err}
+	}
+	if err = ioutil.WriteFile(path, bytes, 0770); err != nil {
+		return &PersistenceError{Op: "write aliases", Path: path, Err: err}
+	}
+	return nil
+}
+
+func (s *SearchServer) readAliasesFromDisk() error {
+	path := filepath.Join(s.savePath, aliasesFileName)
+
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return &PersistenceError{Op: "read aliases", Path: path, Err: err}
+	}
+
+	aliases := map[string]string{}
+	if err = json.Unmarshal(bytes, &aliases); err != nil {
+		return &PersistenceError{Op: "decode aliases", Path: path, Err: err}
+	}
+	s.aliases = aliases
+	return nil
+}
+
+// dropMissingAliases removes the aliases of collections that don't exist, eg:
+// when a collection's directory was deleted. The saved aliases are left as
+// they are until the next change. Returns an error wrapping
+// ErrCollectionNotFound naming the dropped aliases.
+func (s *SearchServer) dropMissingAliases() error {
+	dropped := []string{}
+	for alias, collection := range s.aliases {
+		if _, ok := s.searchEngines[collection]; !ok {
+			delete(s.aliases, alias)
+			dropped = append(dropped, alias+" -> "+collection)
+		}
+	}
+
+	if len(dropped) == 0 {
+		return nil
+	}
+	sort.Strings(dropped)
+	return fmt.Errorf("%w: dropped aliases %v", ErrCollectionNotFound, strings.Join(dropped, ", "))
+}
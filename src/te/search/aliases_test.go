@@ -0,0 +1,113 @@
+package search
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAliases(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+
+	s, _ := NewPersistentSearchServer(dir)
+	s.Create("products_v1")
+	s.Create("products_v2")
+	s.Index("products_v1", Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "old shoes"}}})
+	s.Index("products_v2", Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "new shoes"}}})
+
+	if err := s.SetAlias("products", "products_v1"); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := s.Query("products", Query{Terms: "old"}); err != nil || res.Hits != 1 {
+		t.Errorf("Expected the alias to be queried, got: %v %v", res, err)
+	}
+
+	// writes go through the alias
+	if err := s.Index("products", Document{Id: "2", Fields: map[string]*Field{"title": &Field{Value: "old boots"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get("products_v1", "2"); err != nil {
+		t.Errorf("Expected the document to be written to the collection, got: %v", err)
+	}
+
+	s.SetAlias("products", "products_v2")
+	if res, _ := s.Query("products", Query{Terms: "new"}); res.Hits != 1 {
+		t.Errorf("Expected the alias to be swapped")
+	}
+
+	if err := s.SetAlias("products_v1", "products_v2"); err != ErrCollectionExists {
+		t.Errorf("Expected ErrCollectionExists, got: %v", err)
+	}
+	if err := s.SetAlias("other", "missing"); err != ErrCollectionNotFound {
+		t.Errorf("Expected ErrCollectionNotFound, got: %v", err)
+	}
+	if err := s.SetAlias("other", "products"); err != ErrCollectionNotFound {
+		t.Errorf("Expected aliases not to point to aliases, got: %v", err)
+	}
+	if err := s.Create("products"); err != ErrCollectionExists {
+		t.Errorf("Expected ErrCollectionExists, got: %v", err)
+	}
+	if err := s.Destroy("products_v2"); err != ErrCollectionHasAliases {
+		t.Errorf("Expected ErrCollectionHasAliases, got: %v", err)
+	}
+
+	// restored by a new server, with the collections they point to
+	restored, err := NewPersistentSearchServer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aliases := restored.Aliases(); len(aliases) != 1 || aliases["products"] != "products_v2" {
+		t.Errorf("Expected the aliases to be restored, got: %v", aliases)
+	}
+	if res, err := restored.Query("products", Query{Terms: "new"}); err != nil || res.Hits != 1 {
+		t.Errorf("Expected the alias to query the restored collection, got: %v %v", res, err)
+	}
+	if err := restored.Create("products_v1"); err != ErrCollectionExists {
+		t.Errorf("Expected the collections to be restored, got: %v", err)
+	}
+
+	if err := s.RemoveAlias("products"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RemoveAlias("products"); err != ErrAliasNotFound {
+		t.Errorf("Expected ErrAliasNotFound, got: %v", err)
+	}
+	if _, err := s.Query("products", Query{Terms: "new"}); err != ErrCollectionNotFound {
+		t.Errorf("Expected the alias to be removed, got: %v", err)
+	}
+	if err := s.Destroy("products_v2"); err != nil {
+		t.Errorf("Expected the collection to be destroyed, got: %v", err)
+	}
+}
+
+func TestAliasesOfMissingCollections(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+
+	s, _ := NewPersistentSearchServer(dir)
+	s.Create("products_v1")
+	s.Create("products_v2")
+	s.SetAlias("products", "products_v1")
+	s.SetAlias("latest", "products_v2")
+
+	// deleted behind the server's back
+	os.RemoveAll(filepath.Join(dir, "products_v2"))
+
+	restored, err := NewPersistentSearchServer(dir)
+	if !errors.Is(err, ErrCollectionNotFound) || !strings.Contains(err.Error(), "latest") {
+		t.Errorf("Expected the dropped alias to be reported, got: %v", err)
+	}
+	if aliases := restored.Aliases(); len(aliases) != 1 || aliases["products"] != "products_v1" {
+		t.Errorf("Expected only the alias of the missing collection to be dropped, got: %v", aliases)
+	}
+
+	ioutil.WriteFile(filepath.Join(dir, aliasesFileName), []byte("{"), 0770)
+	var persistErr *PersistenceError
+	if _, err = NewPersistentSearchServer(dir); !errors.As(err, &persistErr) {
+		t.Errorf("Expected a PersistenceError for unreadable aliases, got: %v", err)
+	}
+}
@@ -0,0 +1,357 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"te/search/stemmers"
+)
+
+type (
+	// Analyzer is a chain of char filters, a tokenizer, and token filters. Text
+	// is passed through each char filter, split into terms by the tokenizer, and
+	// the terms are passed through each token filter, in order.
+	Analyzer struct {
+		Name        string
+		CharFilters []CharFilter
+		Tokenizer   WordTokenizer
+		Filters     []TokenFilter
+	}
+
+	// AnalyzerConfig describes an analyzer chain by the names of its parts,
+	// see RegisterCharFilter, RegisterTokenizer and RegisterTokenFilter.
+	AnalyzerConfig struct {
+		CharFilters []string `json:"charFilters,omitempty"`
+		Tokenizer   string   `json:"tokenizer"`
+		Filters     []string `json:"filters,omitempty"`
+	}
+)
+
+const (
+	// DefaultAnalyzer is used for fields without an analyzer of their own
+	DefaultAnalyzer string = "standard"
+
+	// gram sizes of the ngram and edge_ngram filters, see NGramSettings
+	DefaultNGramMin     int = 2
+	DefaultNGramMax     int = 4
+	DefaultEdgeNGramMin int = 2
+	DefaultEdgeNGramMax int = 15
+)
+
+var (
+	charFilters  = map[string]func() CharFilter{}
+	tokenizers   = map[string]func() WordTokenizer{}
+	tokenFilters = map[string]func() TokenFilter{}
+	analyzers    = map[string]AnalyzerConfig{}
+)
+
+func init() {
+	RegisterCharFilter("html_strip", func() CharFilter { return htmlStripFilter{} })
+	RegisterCharFilter("lowercase", func() CharFilter { return lowercaseFilter{} })
+
+	RegisterTokenizer("standard", func() WordTokenizer { return standardTokenizer{} })
+	RegisterTokenizer("whitespace", func() WordTokenizer { return whitespaceTokenizer{} })
+	RegisterTokenizer("keyword", func() WordTokenizer { return keywordTokenizer{} })
+	RegisterTokenizer("unicode", func() WordTokenizer { return unicodeTokenizer{} })
+	RegisterTokenizer("cjk", func() WordTokenizer { return cjkTokenizer{} })
+	RegisterTokenizer("code", func() WordTokenizer { return codeTokenizer{} })
+
+	RegisterCharFilter("nfkc", func() CharFilter { return nfkcFilter{} })
+	RegisterCharFilter("case_fold", func() CharFilter { return caseFoldFilter{} })
+	RegisterCharFilter("accent_fold", func() CharFilter { return accentFoldFilter{} })
+	RegisterCharFilter("markdown_strip", func() CharFilter { return markdownStripFilter{} })
+
+	RegisterTokenFilter("elision_french", func() TokenFilter { return elisionFilter{frenchElisions} })
+	RegisterTokenFilter("possessive", func() TokenFilter { return possessiveFilter{} })
+	RegisterTokenFilter("contractions", func() TokenFilter { return contractionFilter{} })
+	RegisterTokenFilter("ngram", func() TokenFilter {
+		return ngramFilter{min: DefaultNGramMin, max: DefaultNGramMax}
+	})
+	RegisterTokenFilter("edge_ngram", func() TokenFilter {
+		return ngramFilter{min: DefaultEdgeNGramMin, max: DefaultEdgeNGramMax, edge: true}
+	})
+	RegisterTokenFilter("stop", func() TokenFilter { return stopFilter{stopWords} })
+	RegisterTokenFilter("porter", func() TokenFilter {
+		return stemFilter{func() stemmers.Stemmer { return &stemmers.PorterStemmerEnglish{} }}
+	})
+	RegisterTokenFilter("porter2", func() TokenFilter {
+		return stemFilter{func() stemmers.Stemmer { return stemmers.Porter2StemmerEnglish{} }}
+	})
+	RegisterTokenFilter("snowball_french", func() TokenFilter {
+		return stemFilter{func() stemmers.Stemmer { return stemmers.SnowballStemmerFrench{} }}
+	})
+	RegisterTokenFilter("snowball_german", func() TokenFilter {
+		return stemFilter{func() stemmers.Stemmer { return stemmers.SnowballStemmerGerman{} }}
+	})
+	RegisterTokenFilter("snowball_spanish", func() TokenFilter {
+		return stemFilter{func() stemmers.Stemmer { return stemmers.SnowballStemmerSpanish{} }}
+	})
+	RegisterTokenFilter("snowball_dutch", func() TokenFilter {
+		return stemFilter{func() stemmers.Stemmer { return stemmers.SnowballStemmerDutch{} }}
+	})
+	for _, language := range []string{"english", "french", "german", "spanish", "dutch"} {
+		language := language
+		RegisterTokenFilter("stop_"+language, func() TokenFilter { return stopFilter{languageStopWords[language]} })
+	}
+
+	// strips html, expands contractions, removes english stop words and stems
+	// with the porter stemmer
+	RegisterAnalyzer("standard", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "lowercase"},
+		Tokenizer:   "standard",
+		Filters:     []string{"contractions", "possessive", "stop", "porter"},
+	})
+	RegisterAnalyzer("html", analyzers["standard"])
+	// the standard analyzer, for text that isn't html
+	RegisterAnalyzer("text", AnalyzerConfig{
+		CharFilters: []string{"lowercase"},
+		Tokenizer:   "standard",
+		Filters:     []string{"contractions", "possessive", "stop", "porter"},
+	})
+	// language analyzers, html is stripped as with the standard analyzer
+	RegisterAnalyzer("english", analyzers["standard"])
+	// english with the Porter2 stemmer. Stems differ from the standard analyzer,
+	// so collections indexed with it keep it unless they are re-indexed.
+	RegisterAnalyzer("english_porter2", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "lowercase"},
+		Tokenizer:   "standard",
+		Filters:     []string{"contractions", "possessive", "stop", "porter2"},
+	})
+	RegisterAnalyzer("french", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "nfkc", "case_fold"},
+		Tokenizer:   "unicode",
+		Filters:     []string{"elision_french", "stop_french", "snowball_french"},
+	})
+	for _, language := range []string{"german", "spanish", "dutch"} {
+		RegisterAnalyzer(language, AnalyzerConfig{
+			CharFilters: []string{"html_strip", "nfkc", "case_fold"},
+			Tokenizer:   "unicode",
+			Filters:     []string{"stop_" + language, "snowball_" + language},
+		})
+	}
+	// the standard analyzer with unicode word boundaries and normalization
+	RegisterAnalyzer("unicode", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "nfkc", "case_fold"},
+		Tokenizer:   "unicode",
+		Filters:     []string{"contractions", "possessive", "stop", "porter"},
+	})
+	// the unicode analyzer, ignoring accents so `café` matches `cafe`
+	RegisterAnalyzer("unicode_folded", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "nfkc", "case_fold", "accent_fold"},
+		Tokenizer:   "unicode",
+		Filters:     []string{"contractions", "possessive", "stop", "porter"},
+	})
+	// the unicode analyzer, with chinese, japanese and korean text indexed as
+	// character bigrams
+	RegisterAnalyzer("cjk", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "nfkc", "case_fold"},
+		Tokenizer:   "cjk",
+		Filters:     []string{"contractions", "possessive", "stop", "porter"},
+	})
+	// the standard analyzer for markdown, only the prose is indexed. Code
+	// blocks can be indexed separately, see FieldSettings.ExtractCode
+	RegisterAnalyzer("markdown", AnalyzerConfig{
+		CharFilters: []string{"markdown_strip", "html_strip", "lowercase"},
+		Tokenizer:   "standard",
+		Filters:     []string{"contractions", "possessive", "stop", "porter"},
+	})
+	// substrings of words, eg: `4400` matches `XJ-4400`. Usually used for a copy
+	// of a field, see FieldSettings.NGram
+	RegisterAnalyzer("ngram", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "lowercase"},
+		Tokenizer:   "standard",
+		Filters:     []string{"ngram"},
+	})
+	// prefixes of words, eg: `xj44` matches `XJ-4400`
+	RegisterAnalyzer("edge_ngram", AnalyzerConfig{
+		CharFilters: []string{"html_strip", "lowercase"},
+		Tokenizer:   "standard",
+		Filters:     []string{"edge_ngram"},
+	})
+	// lower cased identifiers, the default for extracted code blocks
+	RegisterAnalyzer("code", AnalyzerConfig{
+		CharFilters: []string{"lowercase"},
+		Tokenizer:   "code",
+	})
+	// the whole value as a single lower cased token, no stemming
+	RegisterAnalyzer("keyword", AnalyzerConfig{
+		CharFilters: []string{"lowercase"},
+		Tokenizer:   "keyword",
+	})
+	// lower cased words, no stemming or stop words
+	RegisterAnalyzer("simple", AnalyzerConfig{
+		CharFilters: []string{"lowercase"},
+		Tokenizer:   "standard",
+		Filters:     []string{"possessive"},
+	})
+}
+
+// RegisterCharFilter makes a char filter available to analyzers under `name`
+func RegisterCharFilter(name string, f func() CharFilter) {
+	charFilters[name] = f
+}
+
+// RegisterTokenizer makes a tokenizer available to analyzers under `name`
+func RegisterTokenizer(name string, f func() WordTokenizer) {
+	tokenizers[name] = f
+}
+
+// RegisterTokenFilter makes a token filter available to analyzers under `name`
+func RegisterTokenFilter(name string, f func() TokenFilter) {
+	tokenFilters[name] = f
+}
+
+// RegisterAnalyzer makes an analyzer chain available to all collections under `name`
+func RegisterAnalyzer(name string, config AnalyzerConfig) {
+	analyzers[name] = config
+}
+
+// Analyzers returns the names of all registered analyzers, sorted
+func Analyzers() []string {
+	list := make([]string, 0, len(analyzers))
+	for name := range analyzers {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// NewAnalyzer returns the registered analyzer `name`. Returns an error wrapping
+// ErrUnknownAnalyzer if its not registered.
+func NewAnalyzer(name string) (*Analyzer, error) {
+	config, ok := analyzers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownAnalyzer, name)
+	}
+	return config.build(name)
+}
+
+// NewStandardAnalyzer returns the default analyzer
+func NewStandardAnalyzer() *Analyzer {
+	a, _ := NewAnalyzer(DefaultAnalyzer)
+	return a
+}
+
+func (c AnalyzerConfig) build(name string) (*Analyzer, error) {
+	a := &Analyzer{Name: name}
+
+	for _, n := range c.CharFilters {
+		f, ok := charFilters[n]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v, char filter %v does not exist", ErrUnknownAnalyzer, name, n)
+		}
+		a.CharFilters = append(a.CharFilters, f())
+	}
+
+	t, ok := tokenizers[c.Tokenizer]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v, tokenizer %v does not exist", ErrUnknownAnalyzer, name, c.Tokenizer)
+	}
+	a.Tokenizer = t()
+
+	for _, n := range c.Filters {
+		f, ok := tokenFilters[n]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v, token filter %v does not exist", ErrUnknownAnalyzer, name, n)
+		}
+		a.Filters = append(a.Filters, f())
+	}
+
+	return a, nil
+}
+
+// searchAnalyzer returns the analyzer to use for queries. Analyzers that make
+// n-grams only shorten query terms to the largest gram, so a query term
+// matches the gram, not every gram of the term.
+func (a *Analyzer) searchAnalyzer() *Analyzer {
+	if !a.grams() {
+		return a
+	}
+
+	c := *a
+	c.Filters = make([]TokenFilter, len(a.Filters))
+	for i, f := range a.Filters {
+		if g, ok := f.(ngramFilter); ok {
+			g.query = true
+			f = g
+		}
+		c.Filters[i] = f
+	}
+	return &c
+}
+
+// grams tests if the analyzer makes n-grams
+func (a *Analyzer) grams() bool {
+	for _, f := range a.Filters {
+		if g, ok := f.(ngramFilter); ok && !g.query {
+			return true
+		}
+	}
+	return false
+}
+
+// Analyze runs `text` through the full chain
+func (a *Analyzer) Analyze(text string) []Term {
+	for _, f := range a.CharFilters {
+		text = f.Filter(text)
+	}
+
+	terms := a.Tokenizer.Split(text)
+	for _, f := range a.Filters {
+		terms = f.Filter(terms)
+	}
+
+	// filters may blank out terms, eg: stemming `'s`
+	n := 0
+	for _, t := range terms {
+		if t.Value != "" {
+			terms[n] = t
+			n++
+		}
+	}
+	return terms[0:n]
+}
+
+func (a *Analyzer) Tokenize(text string, stripStopWords bool) []Token {
+	terms := a.Analyze(text)
+
+	seen := map[string]bool{}
+	tokens := make([]Token, 0, len(terms))
+
+	for _, t := range terms {
+		// remove stop words
+		if stripStopWords && t.Stop {
+			continue
+		}
+
+		// ignore duplicates, add to reuslts if unique
+		if !seen[t.Value] {
+			seen[t.Value] = true
+			tokens = append(tokens, Token(t.Value))
+		}
+	}
+
+	return tokens
+}
+
+func (a *Analyzer) TokenizeWithPositions(text string, startPos int) (map[Token][]int, int) {
+	tokens := map[Token][]int{}
+	last := startPos
+
+	for _, t := range a.Analyze(text) {
+		pos := startPos + t.Position
+		if pos > last {
+			last = pos
+		}
+
+		tok := Token(t.Value)
+		positions := tokens[tok]
+		// the same token can be emitted twice at one position, eg: a compound word
+		// and one of its parts stemming to the same value
+		if len(positions) > 0 && positions[len(positions)-1] == pos {
+			continue
+		}
+		tokens[tok] = append(positions, pos)
+	}
+
+	return tokens, last
+}
@@ -0,0 +1,294 @@
+package search
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestAnalyzer(t *testing.T) {
+	a := NewStandardAnalyzer()
+	tokens := a.Tokenize("<p>The Fishes are swimming</p>", true)
+	expected := []Token{"fish", "swim"}
+	if len(tokens) != len(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, tokens)
+	}
+	for i, tok := range expected {
+		if tokens[i] != tok {
+			t.Errorf("Expected %v, got: %v", expected, tokens)
+		}
+	}
+
+	k, _ := NewAnalyzer("keyword")
+	tokens = k.Tokenize(" XJ-4400 Running ", false)
+	if len(tokens) != 1 || tokens[0] != "xj-4400 running" {
+		t.Errorf("Keyword analyzer failed, got: %v", tokens)
+	}
+
+	if _, err := NewAnalyzer("nope"); !errors.Is(err, ErrUnknownAnalyzer) {
+		t.Errorf("Expected ErrUnknownAnalyzer, got: %v", err)
+	}
+}
+
+func TestSimpleTokenizer(t *testing.T) {
+	var tokenizer Tokenizer = &SimpleTokenizer{}
+	tokens := tokenizer.Tokenize("<p>The Fishes are swimming</p>", true)
+	if len(tokens) != 2 || tokens[0] != "fish" || tokens[1] != "swim" {
+		t.Errorf("Expected the standard analyzer's tokens, got: %v", tokens)
+	}
+	if words := tokenizer.CleanAndSplit("<b>Car's</b> co-sleep!"); len(words) != 3 || words[0] != "car" || words[2] != "sleep" {
+		t.Errorf("Expected the clean words, got: %v", words)
+	}
+	if !tokenizer.IsStopWord("the") || tokenizer.IsStopWord("fish") || tokenizer.Stem("runs") != "run" {
+		t.Errorf("Expected stop words and stemming to work")
+	}
+
+	simple := NewSimpleTokenizer()
+	positions, last := simple.TokenizeWithPositions("fish and chips", 10)
+	if last != 13 || positions["chip"][0] != 13 {
+		t.Errorf("Expected positions after 10, got: %v %v", positions, last)
+	}
+}
+
+func TestFieldAnalyzers(t *testing.T) {
+	s := NewSearchEngine()
+	err := s.SetSettings(Settings{
+		Fields: map[string]FieldSettings{
+			"sku":  {Analyzer: "keyword"},
+			"body": {Analyzer: "html"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"sku":  &Field{Value: "Running"},
+		"body": &Field{Value: "<b>Running</b> shoes"},
+	}})
+
+	// the keyword field isn't stemmed
+	if _, ok := s.documents[1].Fields["sku"].Tokens["running"]; !ok {
+		t.Errorf("Expected the keyword field to keep `running`, got: %v", s.documents[1].Fields["sku"].Tokens)
+	}
+	if _, ok := s.documents[1].Fields["body"].Tokens["run"]; !ok {
+		t.Errorf("Expected the html field to be stripped and stemmed, got: %v", s.documents[1].Fields["body"].Tokens)
+	}
+	if _, ok := s.documents[1].Fields["body"].Tokens["b"]; ok {
+		t.Errorf("Expected html tags to be stripped, got: %v", s.documents[1].Fields["body"].Tokens)
+	}
+
+	res := s.QueryField("sku", "running")
+	if res.Hits != 1 {
+		t.Errorf("Expected a keyword field match, got: %v", res.Hits)
+	}
+	res = s.QueryField("sku", "run")
+	if res.Hits != 0 {
+		t.Errorf("Expected no stemmed match on a keyword field, got: %v", res.Hits)
+	}
+	res = s.Query(Query{Terms: "running"})
+	if res.Hits != 1 {
+		t.Errorf("Expected a match across fields, got: %v", res.Hits)
+	}
+
+	err = s.SetSettings(Settings{Fields: map[string]FieldSettings{"sku": {Analyzer: "nope"}}})
+	if !errors.Is(err, ErrUnknownAnalyzer) {
+		t.Errorf("Expected ErrUnknownAnalyzer, got: %v", err)
+	}
+	if s.Settings().Fields["sku"].Analyzer != "keyword" {
+		t.Errorf("Expected invalid settings not to be applied")
+	}
+}
+
+func TestCustomAnalyzer(t *testing.T) {
+	s := NewSearchEngine()
+	err := s.SetSettings(Settings{
+		Analyzer: "exact",
+		Analyzers: map[string]AnalyzerConfig{
+			"exact": {CharFilters: []string{"lowercase"}, Tokenizer: "whitespace"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "Turbo-Snail racing"}}})
+	if s.Query(Query{Terms: "turbo-snail"}).Hits != 1 || s.Query(Query{Terms: "turbo"}).Hits != 0 {
+		t.Errorf("Expected the whitespace tokenizer to keep compound words whole")
+	}
+
+	err = s.SetSettings(Settings{Analyzers: map[string]AnalyzerConfig{
+		"bad": {Tokenizer: "missing"},
+	}, Analyzer: "bad"})
+	if !errors.Is(err, ErrUnknownAnalyzer) {
+		t.Errorf("Expected ErrUnknownAnalyzer for a missing tokenizer, got: %v", err)
+	}
+}
+
+func TestSettingsPersistence(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+
+	s, _ := NewPersistentSearchEngine(dir)
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{"sku": {Analyzer: "keyword"}}})
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"sku": &Field{Value: "Running"}}})
+
+	s, err := NewPersistentSearchEngine(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Settings().Fields["sku"].Analyzer != "keyword" {
+		t.Errorf("Expected settings to be restored, got: %v", s.Settings())
+	}
+	if s.QueryField("sku", "running").Hits != 1 {
+		t.Errorf("Expected the restored document to match")
+	}
+}
+
+func TestUpdateSettings(t *testing.T) {
+	s := NewSearchEngine()
+	s.SetSettings(Settings{
+		Analyzer: "text",
+		Fields:   map[string]FieldSettings{"sku": {Analyzer: "keyword"}},
+		Synonyms: []SynonymRule{{Terms: []string{"sofa", "couch"}}},
+	})
+
+	settings, err := s.UpdateSettings([]byte(`{"page_size": 5, "fields": {"body": {"analyzer": "html"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.Analyzer != "text" || len(settings.Synonyms) != 1 || settings.PageSize != 5 {
+		t.Errorf("Expected the settings left out to be kept, got: %v", settings)
+	}
+	if settings.Fields["sku"].Analyzer != "keyword" || settings.Fields["body"].Analyzer != "html" {
+		t.Errorf("Expected the fields to be merged, got: %v", settings.Fields)
+	}
+
+	if settings, _ = s.UpdateSettings([]byte(`{"synonyms": null, "analyzer": ""}`)); settings.Synonyms != nil || settings.Analyzer != "" || settings.PageSize != 5 {
+		t.Errorf("Expected only the null settings to be reset, got: %v", settings)
+	}
+
+	if _, err = s.UpdateSettings([]byte(`{"page_size": "five"}`)); !errors.Is(err, ErrInvalidSettings) {
+		t.Errorf("Expected ErrInvalidSettings, got: %v", err)
+	}
+	if _, err = s.UpdateSettings([]byte(`{"analyzer": "nope"}`)); !errors.Is(err, ErrUnknownAnalyzer) || s.Settings().PageSize != 5 {
+		t.Errorf("Expected the settings to be unchanged, got: %v %v", err, s.Settings())
+	}
+
+	// null resets numbers and bools too, not only lists
+	s.UpdateSettings([]byte(`{"disable_stop_words": true}`))
+	settings, _ = s.UpdateSettings([]byte(`{"page_size": null, "disable_stop_words": null}`))
+	if settings.PageSize != 0 || settings.DisableStopWords || settings.Fields["sku"].Analyzer != "keyword" {
+		t.Errorf("Expected the null settings to be reset, got: %v", settings)
+	}
+	if s.Settings().PageSize != 0 || s.Settings().DisableStopWords {
+		t.Errorf("Expected the engine to use the reset settings, got: %v", s.Settings())
+	}
+}
+
+func TestQuerySettings(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+
+	s, _ := NewPersistentSearchEngine(dir)
+	for _, id := range []string{"1", "2", "3"} {
+		s.Index(Document{Id: id, Fields: map[string]*Field{"title": &Field{Value: "fishing guide"}, "body": &Field{Value: "trout"}}})
+	}
+
+	if err := s.SetSettings(Settings{PageSize: 2, ReturnFields: []string{"title"}, DisableWildCardQueries: true}); err != nil {
+		t.Fatal(err)
+	}
+	res := s.Query(Query{Terms: "guide"})
+	if res.PageSize != 2 || len(res.Documents) != 2 || res.Documents[0].Fields["title"] == "" || res.Documents[0].Fields["body"] != "" {
+		t.Errorf("Expected the default page size and fields, got: %v", res)
+	}
+	if res = s.Query(Query{Terms: "guide", PageSize: 5, ReturnFields: "body"}); len(res.Documents) != 3 || res.Documents[0].Fields["body"] == "" {
+		t.Errorf("Expected the query to override the defaults, got: %v", res)
+	}
+	if s.SupportWildCardQuries || s.kIndex.Len() != 0 || s.Query(Query{Terms: "gui", PartialMatch: true}).Hits != 0 {
+		t.Errorf("Expected wild card queries to be disabled")
+	}
+
+	s, _ = NewPersistentSearchEngine(dir)
+	if s.SupportWildCardQuries || s.Settings().PageSize != 2 {
+		t.Errorf("Expected the settings to be restored, got: %v", s.Settings())
+	}
+
+	s.SetSettings(Settings{})
+	if !s.SupportWildCardQuries || s.Query(Query{Terms: "gui", PartialMatch: true}).Hits != 3 {
+		t.Errorf("Expected the k-gram index to be rebuilt")
+	}
+	s, _ = NewPersistentSearchEngine(dir)
+	if s.Query(Query{Terms: "gui", PartialMatch: true}).Hits != 3 {
+		t.Errorf("Expected the rebuilt k-gram index to be saved")
+	}
+
+	if err := s.SetSettings(Settings{PageSize: -1}); !errors.Is(err, ErrInvalidSettings) {
+		t.Errorf("Expected an invalid settings error, got: %v", err)
+	}
+}
+
+func TestLanguageAnalyzers(t *testing.T) {
+	tests := []struct {
+		analyzer string
+		text     string
+		expected []Token
+	}{
+		{"english_porter2", "Generously knitted", []Token{"generous", "knit"}},
+		{"french", "L'homme continuellement", []Token{"homm", "continuel"}},
+		{"french", "les chevaux", []Token{"cheval"}},
+		{"german", "Die Häuser", []Token{"haus"}},
+		{"spanish", "las organizaciones", []Token{"organiz"}},
+		{"dutch", "de kinderen", []Token{"kinder"}},
+	}
+
+	for _, test := range tests {
+		a, err := NewAnalyzer(test.analyzer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tokens := a.Tokenize(test.text, true)
+		if len(tokens) != len(test.expected) {
+			t.Errorf("%v: expected %v, got: %v", test.analyzer, test.expected, tokens)
+			continue
+		}
+		for i, tok := range test.expected {
+			if tokens[i] != tok {
+				t.Errorf("%v: expected %v, got: %v", test.analyzer, test.expected, tokens)
+			}
+		}
+	}
+}
+
+func TestContractions(t *testing.T) {
+	a := NewStandardAnalyzer()
+	terms := a.Analyze("They won't stop, I'm sure it's abcd'efg O'Niel's")
+
+	expected := []struct {
+		value    string
+		position int
+	}{
+		{"thei", 1}, {"will", 2}, {"not", 3}, {"stop", 4}, {"i", 5}, {"am", 6},
+		{"sure", 7}, {"it", 8}, {"is", 9}, {"abcd'efg", 10}, {"oniel", 11},
+	}
+	if len(terms) != len(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, terms)
+	}
+	for i, e := range expected {
+		if terms[i].Value != e.value || terms[i].Position != e.position {
+			t.Errorf("Expected %v at %v, got: %v at %v", e.value, e.position, terms[i].Value, terms[i].Position)
+		}
+	}
+
+	s := NewSearchEngine()
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"body": &Field{Value: "They won't stop"}}})
+
+	for _, phrase := range []string{"will not stop", "won't stop", "they will"} {
+		res := s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Terms: phrase, Phrase: true}}}})
+		if res.Hits != 1 {
+			t.Errorf("Expected the phrase %q to match, got: %v", phrase, res.Hits)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrCollectionNotFound is returned when a named collection does not exist on a SearchServer
+	ErrCollectionNotFound = errors.New("collection does not exist")
+	// ErrCollectionExists is returned when creating a collection with a name that is already in use
+	ErrCollectionExists = errors.New("collection already exists")
+	// ErrInvalidName is returned for collection names that are not alpha-numeric. Dashes and underscores are allowed.
+	ErrInvalidName = errors.New("invalid collection name")
+	// ErrUnknownAnalyzer is returned when an analyzer, or a part of one, is not registered
+	ErrUnknownAnalyzer = errors.New("unknown analyzer")
+	// ErrInvalidSettings is returned when collection settings are not valid
+	ErrInvalidSettings = errors.New("invalid settings")
+	// ErrDocumentNotFound is returned when operating on a document id that is not in the index
+	ErrDocumentNotFound = errors.New("document does not exist")
+	// ErrVersionConflict is returned when the expected version of a document does not match its current version
+	ErrVersionConflict = errors.New("document version conflict")
+	// ErrAliasNotFound is returned when removing an alias that does not exist
+	ErrAliasNotFound = errors.New("alias does not exist")
+	// ErrCollectionHasAliases is returned when destroying a collection that aliases point to
+	ErrCollectionHasAliases = errors.New("collection has aliases")
+	// ErrRebuildInProgress is returned when rebuilding a collection that is already being rebuilt
+	ErrRebuildInProgress = errors.New("rebuild already in progress")
+)
+
+// PersistenceError is returned when reading or writing a persistent search engine fails
+type PersistenceError struct {
+	// the operation that failed, eg: `write index`
+	Op   string
+	Path string
+	Err  error
+}
+
+func (e *PersistenceError) Error() string {
+	return fmt.Sprintf("search: %v %v: %v", e.Op, e.Path, e.Err.Error())
+}
+
+func (e *PersistenceError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,188 @@
+package search
+
+import (
+	"strings"
+	"te/search/stemmers"
+	"unicode"
+)
+
+type (
+	// CharFilter transforms text before it is split into terms
+	CharFilter interface {
+		Filter(text string) string
+	}
+
+	// TokenFilter transforms, removes, or adds terms after text has been split.
+	// Filters must not keep state between calls, analyzers are shared.
+	TokenFilter interface {
+		Filter(terms []Term) []Term
+	}
+
+	// strips html tags and unescapes entities
+	htmlStripFilter struct{}
+
+	lowercaseFilter struct{}
+
+	// marks stop words, see Term.Stop
+	stopFilter struct {
+		words map[string]bool
+	}
+
+	// normalizes text to Unicode NFKC form
+	nfkcFilter struct{}
+
+	// applies Unicode full case folding
+	caseFoldFilter struct{}
+
+	// removes diacritics, eg: `café` becomes `cafe`
+	accentFoldFilter struct{}
+
+	// removes elided articles and pronouns, eg: `l'homme` becomes `homme`
+	elisionFilter struct {
+		articles []string
+	}
+
+	// removes the english possessive `'s`
+	possessiveFilter struct{}
+
+	// expands english contractions, eg: `won't` becomes `will` `not`. Terms
+	// after a contraction move along so phrases still line up.
+	contractionFilter struct{}
+
+	// replaces terms with their n-grams, or with edge n-grams, the grams at the
+	// start of the term. Grams are made from the letters and digits of a term,
+	// so `xj-44` has the edge grams `xj`, `xj4` and `xj44`. For queries the
+	// filter only shortens terms to `max`, see Analyzer.searchAnalyzer.
+	ngramFilter struct {
+		min   int
+		max   int
+		edge  bool
+		query bool
+	}
+
+	// stems terms with a stemmer from the stemmers package
+	stemFilter struct {
+		stemmer func() stemmers.Stemmer
+	}
+)
+
+// articles removed by the French elision filter
+var frenchElisions = []string{"l", "m", "t", "qu", "n", "s", "j", "d", "c", "jusqu", "quoiqu", "lorsqu", "puisqu"}
+
+func (f htmlStripFilter) Filter(text string) string {
+	return stripHtml(text)
+}
+
+func (f lowercaseFilter) Filter(text string) string {
+	return strings.ToLower(text)
+}
+
+func (f stopFilter) Filter(terms []Term) []Term {
+	for i, t := range terms {
+		if f.words[t.Value] {
+			terms[i].Stop = true
+		}
+	}
+	return terms
+}
+
+func (f nfkcFilter) Filter(text string) string {
+	return normalizeNFKC(text)
+}
+
+func (f caseFoldFilter) Filter(text string) string {
+	return foldCase(text)
+}
+
+func (f accentFoldFilter) Filter(text string) string {
+	return foldAccents(text)
+}
+
+func (f elisionFilter) Filter(terms []Term) []Term {
+	for i, t := range terms {
+		for _, a := range f.articles {
+			if rest := strings.TrimPrefix(t.Value, a+"'"); rest != t.Value {
+				terms[i].Value = rest
+				break
+			}
+		}
+	}
+	return terms
+}
+
+func (f possessiveFilter) Filter(terms []Term) []Term {
+	for i, t := range terms {
+		terms[i].Value = strings.TrimSuffix(t.Value, "'s")
+	}
+	return terms
+}
+
+func (f contractionFilter) Filter(terms []Term) []Term {
+	res := make([]Term, 0, len(terms))
+	shift := 0
+
+	for _, t := range terms {
+		t.Position += shift
+
+		words, ok := contractionTable[t.Value]
+		if !ok {
+			res = append(res, t)
+			continue
+		}
+
+		for i, w := range words {
+			res = append(res, Term{Value: w, Word: w, Position: t.Position + i, Stop: t.Stop})
+		}
+		shift += len(words) - 1
+	}
+	return res
+}
+
+func (f ngramFilter) Filter(terms []Term) []Term {
+	res := make([]Term, 0, len(terms))
+
+	for _, t := range terms {
+		if t.Stop {
+			continue
+		}
+
+		runes := []rune(strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, t.Value))
+
+		if f.query {
+			if len(runes) > f.max {
+				runes = runes[:f.max]
+			}
+			if len(runes) > 0 {
+				t.Value = string(runes)
+				res = append(res, t)
+			}
+			continue
+		}
+
+		for start := 0; start < len(runes); start++ {
+			for n := f.min; n <= f.max && start+n <= len(runes); n++ {
+				g := t
+				g.Value = string(runes[start : start+n])
+				res = append(res, g)
+			}
+			if f.edge {
+				break
+			}
+		}
+	}
+	return res
+}
+
+func (f stemFilter) Filter(terms []Term) []Term {
+	// stemmers can keep state while stemming, so one is created per call
+	stemmer := f.stemmer()
+	for i, t := range terms {
+		terms[i].Value = stemmer.Stem(t.Value)
+	}
+	return terms
+}
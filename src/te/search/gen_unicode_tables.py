@@ -0,0 +1,66 @@
+#!/usr/bin/env python3
+# Generates unicode_tables.go, the normalization and case folding tables used
+# by the unicode tokenizer. Run with `go generate`.
+
+import sys
+import unicodedata
+
+def chars():
+    for cp in range(0x110000):
+        # surrogates, and hangul syllables which are decomposed algorithmically
+        if 0xD800 <= cp <= 0xDFFF or 0xAC00 <= cp <= 0xD7A3:
+            continue
+        yield cp, chr(cp)
+
+def lit(s):
+    return '"' + ''.join('\\u%04X' % ord(c) if ord(c) <= 0xFFFF else '\\U%08X' % ord(c) for c in s) + '"'
+
+decomp, ccc, compose, fold = [], [], [], []
+
+for cp, c in chars():
+    d = unicodedata.normalize('NFKD', c)
+    if d != c:
+        decomp.append((cp, d))
+
+    cc = unicodedata.combining(c)
+    if cc:
+        ccc.append((cp, cc))
+
+    # primary composites, those that compose back from their canonical decomposition
+    dm = unicodedata.decomposition(c)
+    if dm and not dm.startswith('<'):
+        parts = [int(p, 16) for p in dm.split()]
+        if len(parts) == 2 and unicodedata.normalize('NFC', chr(parts[0]) + chr(parts[1])) == c:
+            compose.append((parts[0], parts[1], cp))
+
+    f = c.casefold()
+    if f != c:
+        fold.append((cp, f))
+
+out = sys.stdout
+out.write('// Code generated by gen_unicode_tables.py from Unicode %s; DO NOT EDIT.\n\n' % unicodedata.unidata_version)
+out.write('package search\n\n')
+
+out.write('// full compatibility decompositions (NFKD) of single runes\n')
+out.write('var decompositions = map[rune]string{\n')
+for cp, d in decomp:
+    out.write('\t0x%04X: %s,\n' % (cp, lit(d)))
+out.write('}\n\n')
+
+out.write('// canonical combining classes, runes not listed have class 0\n')
+out.write('var combiningClasses = map[rune]uint8{\n')
+for cp, cc in ccc:
+    out.write('\t0x%04X: %d,\n' % (cp, cc))
+out.write('}\n\n')
+
+out.write('// canonical compositions of rune pairs\n')
+out.write('var compositions = map[[2]rune]rune{\n')
+for a, b, cp in compose:
+    out.write('\t{0x%04X, 0x%04X}: 0x%04X,\n' % (a, b, cp))
+out.write('}\n\n')
+
+out.write('// full case folding\n')
+out.write('var caseFolds = map[rune]string{\n')
+for cp, f in fold:
+    out.write('\t0x%04X: %s,\n' % (cp, lit(f)))
+out.write('}\n')
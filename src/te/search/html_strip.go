@@ -2,43 +2,285 @@ package search
 
 import (
 	"html"
-	"unicode"
+	"strings"
 )
 
+type (
+	// the text content of a html document, and the parts of it that describe
+	// the document
+	htmlDocument struct {
+		Text        string
+		Title       string
+		Description string
+		Headings    []string
+	}
+
+	htmlTag struct {
+		name    string
+		closing bool
+		attrs   map[string]string
+	}
+
+	// collects text, block elements become word boundaries
+	textBuffer struct {
+		b       []byte
+		pending bool
+	}
+)
+
+// names and default boosts of the fields extracted from html, see FieldSettings.ExtractHTML
+const (
+	htmlTitleField       string  = "title"
+	htmlDescriptionField string  = "description"
+	htmlHeadingsField    string  = "headings"
+	htmlTitleBoost       float64 = 3
+	htmlHeadingBoost     float64 = 2
+)
+
+// elements that separate the text before and after them
+var htmlBlockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true,
+	"caption": true, "dd": true, "div": true, "dl": true, "dt": true, "fieldset": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true, "h1": true,
+	"h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "header": true,
+	"hr": true, "li": true, "main": true, "nav": true, "ol": true, "option": true,
+	"p": true, "pre": true, "section": true, "table": true, "td": true, "th": true,
+	"title": true, "tr": true, "ul": true,
+}
+
+// stripHtml returns the text content of html `val`, see extractHtml
 func stripHtml(val string) string {
-	length := len(val)
-	res := make([]rune, length)
-	n := 0
-	inTag := false
-
-	for i, r := range val {
-		// if we are not in a tag, and this opens one, replace the tag with an
-		// empty space, mark tag open, and continue
-		if !inTag && r == '<' && i+1 < length {
-			// the rune following it must be a letter (a-z) or the start of
-			// a closing tag
-			next := rune(val[i+1])
-			if next == '/' || unicode.IsLetter(next) {
-				inTag = true
-				continue
+	return extractHtml(val).Text
+}
+
+// extractHtml returns the text content of html or xml `val`. Comments, and
+// the contents of script and style elements are skipped, block elements
+// separate words. A `<` that doesn't start a tag is kept as text, eg: `2 < 4`.
+func extractHtml(val string) htmlDocument {
+	var doc htmlDocument
+	text := &textBuffer{}
+
+	// the title or heading being read
+	var capture *textBuffer
+	captureName := ""
+
+	write := func(c byte) {
+		text.writeByte(c)
+		if capture != nil {
+			capture.writeByte(c)
+		}
+	}
+
+	for i := 0; i < len(val); {
+		c := val[i]
+		if c != '<' {
+			write(c)
+			i++
+			continue
+		}
+
+		rest := val[i:]
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			i += skipPast(rest, 4, "-->")
+			continue
+		case strings.HasPrefix(rest, "<![CDATA["):
+			end := strings.Index(rest, "]]>")
+			if end == -1 {
+				end = len(rest)
+			}
+			for j := len("<![CDATA["); j < end; j++ {
+				write(rest[j])
+			}
+			i += skipPast(rest, end, "]]>")
+			continue
+		case len(rest) > 1 && (rest[1] == '!' || rest[1] == '?'):
+			// doctypes and processing instructions
+			i += skipPast(rest, 2, ">")
+			continue
+		}
+
+		tag, n, ok := parseHtmlTag(rest)
+		if !ok {
+			write(c)
+			i++
+			continue
+		}
+		i += n
+
+		if !tag.closing && (tag.name == "script" || tag.name == "style") {
+			i += skipPastFold(val[i:], "</"+tag.name)
+			i += skipPast(val[i:], 0, ">")
+			continue
+		}
+
+		if tag.name == "meta" && strings.EqualFold(tag.attrs["name"], "description") {
+			doc.Description = collapseSpace(html.UnescapeString(tag.attrs["content"]))
+		}
+
+		if htmlBlockElements[tag.name] {
+			text.boundary()
+			if capture != nil {
+				capture.boundary()
 			}
 		}
 
-		// if we are not in a tag, add the rune and continue
-		if !inTag {
-			res[n] = r
-			n++
+		if tag.name != "title" && !isHeading(tag.name) {
 			continue
 		}
 
-		// if we are in a tag, and this closes it. Mark tag end and continue
-		if inTag && r == '>' {
-			inTag = false
+		if !tag.closing {
+			capture = &textBuffer{}
+			captureName = tag.name
 			continue
 		}
+
+		if capture != nil && captureName == tag.name {
+			s := collapseSpace(capture.String())
+			if tag.name == "title" {
+				doc.Title = s
+			} else if s != "" {
+				doc.Headings = append(doc.Headings, s)
+			}
+			capture = nil
+		}
+	}
+
+	doc.Text = text.String()
+	return doc
+}
+
+// parseHtmlTag reads the tag `s` starts with. Returns false if `s` doesn't
+// start with a tag, or the tag is never closed.
+func parseHtmlTag(s string) (htmlTag, int, bool) {
+	tag := htmlTag{attrs: map[string]string{}}
+	i := 1
+
+	if i < len(s) && s[i] == '/' {
+		tag.closing = true
+		i++
+	}
+	if i >= len(s) || !isAsciiLetter(s[i]) {
+		return tag, 0, false
+	}
+
+	start := i
+	for i < len(s) && isTagNameByte(s[i]) {
+		i++
+	}
+	tag.name = strings.ToLower(s[start:i])
+
+	for i < len(s) {
+		switch c := s[i]; {
+		case c == '>':
+			return tag, i + 1, true
+		case c == '/' || isSpaceByte(c):
+			i++
+		default:
+			// attribute name, optionally followed by a value
+			start := i
+			for i < len(s) && s[i] != '=' && s[i] != '>' && s[i] != '/' && !isSpaceByte(s[i]) {
+				i++
+			}
+			name := strings.ToLower(s[start:i])
+
+			for i < len(s) && isSpaceByte(s[i]) {
+				i++
+			}
+			if i >= len(s) || s[i] != '=' {
+				tag.attrs[name] = ""
+				continue
+			}
+			i++
+			for i < len(s) && isSpaceByte(s[i]) {
+				i++
+			}
+
+			// quoted values can contain `<` and `>`
+			if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+				end := strings.IndexByte(s[i+1:], s[i])
+				if end == -1 {
+					return tag, 0, false
+				}
+				tag.attrs[name] = s[i+1 : i+1+end]
+				i += end + 2
+				continue
+			}
+
+			start = i
+			for i < len(s) && s[i] != '>' && !isSpaceByte(s[i]) {
+				i++
+			}
+			tag.attrs[name] = s[start:i]
+		}
+	}
+
+	return tag, 0, false
+}
+
+// skipPast returns the length of `s` up to and including the first `end`
+// after `from`, or len(s) if there isn't one
+func skipPast(s string, from int, end string) int {
+	i := strings.Index(s[from:], end)
+	if i == -1 {
+		return len(s)
 	}
+	return from + i + len(end)
+}
+
+// skipPastFold returns the index of the first `end` in `s` ignoring case,
+// or len(s) if there isn't one
+func skipPastFold(s string, end string) int {
+	i := strings.Index(strings.ToLower(s), end)
+	if i == -1 {
+		return len(s)
+	}
+	return i
+}
+
+func (t *textBuffer) writeByte(c byte) {
+	if t.pending && isWordByte(c) && len(t.b) > 0 && !isSpaceByte(t.b[len(t.b)-1]) {
+		t.b = append(t.b, ' ')
+	}
+	t.pending = false
+	t.b = append(t.b, c)
+}
+
+// boundary separates the next word from the text before it
+func (t *textBuffer) boundary() {
+	t.pending = true
+}
+
+// String returns the text with entities, such as `&apos;`, unescaped
+func (t *textBuffer) String() string {
+	return html.UnescapeString(string(t.b))
+}
 
-	s := string(res[0:n])
-	// remove `&apos;` and things like that
-	return html.UnescapeString(s)
+func isHeading(name string) bool {
+	return len(name) == 2 && name[0] == 'h' && name[1] >= '1' && name[1] <= '6'
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func isAsciiLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isTagNameByte(c byte) bool {
+	return isAsciiLetter(c) || (c >= '0' && c <= '9') || c == '-' || c == ':' || c == '_'
+}
+
+// multi byte characters are treated as part of a word
+func isWordByte(c byte) bool {
+	return isAsciiLetter(c) || (c >= '0' && c <= '9') || c >= 0x80
+}
+
+func isSpaceByte(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\f':
+		return true
+	}
+	return false
 }
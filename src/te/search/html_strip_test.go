@@ -55,3 +55,72 @@ func TestHtmlStripMath(t *testing.T) {
 		t.Errorf("expected: %v\ngot: %v", e, r)
 	}
 }
+
+func TestHtmlStripStructure(t *testing.T) {
+	tests := map[string]string{
+		`<script>if (a < b) { x = "</p>" }</script>cat`:          "cat",
+		`<STYLE type="text/css">p > a { color: red }</STYLE>cat`: "cat",
+		`<!-- <p>dog</p> -->cat`:                                 "cat",
+		`<a title="a < b > c" href="#">cat</a>`:                  "cat",
+		`<p>cat</p><p>dog</p>`:                                   "cat dog",
+		"<li>cat</li>\n<li>dog</li>":                             "cat\ndog",
+		`cat<br/>dog`:                                            "cat dog",
+		`<b>c</b>at`:                                             "cat",
+		`<!DOCTYPE html><?xml version="1.0"?>cat`:                "cat",
+		`<![CDATA[cat & dog]]>`:                                  "cat & dog",
+	}
+
+	for s, e := range tests {
+		if r := stripHtml(s); r != e {
+			t.Errorf("expected: %v\ngot: %v", e, r)
+		}
+	}
+}
+
+func TestExtractHtml(t *testing.T) {
+	doc := extractHtml(`<html><head><title>Fishing  Guide</title>
+		<meta name="description" content="Where to fish &amp; when"></head>
+		<body><h1>Lakes</h1><p>Go early</p><h2>Rivers <em>and</em> streams</h2></body></html>`)
+
+	if doc.Title != "Fishing Guide" {
+		t.Errorf("Unexpected title: %q", doc.Title)
+	}
+	if doc.Description != "Where to fish & when" {
+		t.Errorf("Unexpected description: %q", doc.Description)
+	}
+	if len(doc.Headings) != 2 || doc.Headings[0] != "Lakes" || doc.Headings[1] != "Rivers and streams" {
+		t.Errorf("Unexpected headings: %q", doc.Headings)
+	}
+}
+
+func TestExtractHtmlFields(t *testing.T) {
+	s := NewSearchEngine()
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{"body": {ExtractHTML: true}}})
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"body": &Field{Value: "<h1>Trout</h1><p>Salmon and salmon</p>"}}})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"body": &Field{Value: "<h1>Salmon</h1><p>Trout</p>"}}})
+
+	d, _ := s.Get("1")
+	if d.Fields["body.headings"] == nil || d.Fields["body.headings"].Value != "Trout" {
+		t.Errorf("Expected the headings to be extracted, got: %v", d.Fields)
+	}
+	if _, ok := d.Fields["body.title"]; ok {
+		t.Errorf("Expected empty extracted fields to be left out")
+	}
+
+	// a heading match outranks the same term twice in the body
+	res := s.Query(Query{Terms: "salmon"})
+	if res.Hits != 2 || res.Documents[0].Id != "2" {
+		t.Errorf("Expected the heading match first, got: %v", res.Documents)
+	}
+
+	if res := s.QueryField("body.headings", "trout"); res.Hits != 1 {
+		t.Errorf("Expected the headings field to be searchable, got: %v", res.Hits)
+	}
+
+	s.Update("1", map[string]*Field{"body": &Field{Value: "<title>Pike</title>"}})
+	d, _ = s.Get("1")
+	if _, ok := d.Fields["body.headings"]; ok || d.Fields["body.title"].Value != "Pike" {
+		t.Errorf("Expected extracted fields to be updated, got: %v", d.Fields)
+	}
+}
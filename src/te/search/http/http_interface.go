@@ -9,18 +9,24 @@
 //     sh "te/search/http"
 // )
 //
-// http.HandleFunc("/my/search/end/point", sh.HandlerFunc(search.NewSearchServer()))
+// http.HandleFunc("/my/search/end/point", sh.HandlerFunc(search.NewSearchServer(), ""))
 // http.ListenAndServe(":80", nil)
+//
+// To serve both the legacy API and the REST API (see rest.go):
+//
+// http.ListenAndServe(":80", sh.Handler(search.NewSearchServer(), ""))
 
 package http
 
 import (
 	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"te/search"
@@ -33,6 +39,35 @@ type document struct {
 	Fields map[string]string `json:"fields"`
 }
 
+// format documents are returned in by `action=get` and the REST API
+type storedDocument struct {
+	Id          string            `json:"id"`
+	Version     int               `json:"version"`
+	Fields      map[string]string `json:"fields"`
+	DateAdded   time.Time         `json:"dateAdded"`
+	DateUpdated time.Time         `json:"dateUpdated"`
+}
+
+func newStoredDocument(d search.Document) storedDocument {
+	doc := storedDocument{
+		Id:          d.Id,
+		Version:     d.Version,
+		Fields:      map[string]string{},
+		DateAdded:   d.DateAdded,
+		DateUpdated: d.DateUpdated,
+	}
+	for k, v := range d.Fields {
+		doc.Fields[k] = v.Value
+	}
+	return doc
+}
+
+// format of partial document updates. A field set to null is removed.
+type documentUpdate struct {
+	Id     string             `json:"id"`
+	Fields map[string]*string `json:"fields"`
+}
+
 // Returns a HTTP handler function that will pass requests through
 // to the specified SearchServer
 func HandlerFunc(s *search.SearchServer, authToken string) http.HandlerFunc {
@@ -40,23 +75,51 @@ func HandlerFunc(s *search.SearchServer, authToken string) http.HandlerFunc {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		// ifs its a get request, it can only be a query request, so hand it off
 		if r.Method == "GET" {
+			switch r.URL.Query().Get("action") {
+			case "get":
+				getHandler(s, w, r)
+				return
+			case "synonyms":
+				synonymsHandler(s, w, r)
+				return
+			case "stats":
+				statsHandler(s, w, r)
+				return
+			case "collections":
+				collectionsHandler(s, w, r)
+				return
+			case "aliases":
+				aliasesHandler(s, w, r)
+				return
+			case "settings":
+				settingsHandler(s, w, r)
+				return
+			case "terms":
+				// the term dictionary is for admins
+				if validAuthToken(w, r, authToken) {
+					termsHandler(s, w, r)
+				}
+				return
+			}
 			queryHandler(s, w, r)
 			return
 		}
 
 		if r.Method != "POST" {
-			respondWithError(w, r, "unsupport HTTP method")
+			respondWithErrorCode(w, r, "unsupport HTTP method", http.StatusMethodNotAllowed)
 			return
 		}
 
 		params := r.URL.Query()
 
-		if authToken != "" {
-			token := params.Get("authtoken")
-			if token != authToken {
-				respondWithError(w, r, "Auth token invalid")
-				return
-			}
+		// JSON queries are POSTed, but like GET queries they don't require auth
+		if params.Get("action") == "search" {
+			searchHandler(s, w, r)
+			return
+		}
+
+		if !validAuthToken(w, r, authToken) {
+			return
 		}
 
 		action := params.Get("action")
@@ -67,14 +130,36 @@ func HandlerFunc(s *search.SearchServer, authToken string) http.HandlerFunc {
 			destroyHandler(s, w, r)
 		case "index":
 			indexHandler(s, w, r)
+		case "update":
+			updateHandler(s, w, r)
 		case "remove":
 			removeHandler(s, w, r)
+		case "set_synonyms":
+			setSynonymsHandler(s, w, r)
+		case "set_settings":
+			setSettingsHandler(s, w, r)
+		case "rebuild":
+			rebuildHandler(s, w, r)
+		case "set_alias":
+			setAliasHandler(s, w, r)
+		case "remove_alias":
+			removeAliasHandler(s, w, r)
 		default:
 			respondWithError(w, r, "Unknown action specified")
 		}
 	}
 }
 
+// validAuthToken checks the `authtoken` query parameter, responding with an
+// error if it doesn't match
+func validAuthToken(w http.ResponseWriter, r *http.Request, authToken string) bool {
+	if authToken != "" && r.URL.Query().Get("authtoken") != authToken {
+		respondWithErrorCode(w, r, "Auth token invalid", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
 // create a search engine
 func createHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
 	params := r.URL.Query()
@@ -85,15 +170,58 @@ func createHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if s.Exists(collection) {
-		respondWithError(w, r, "Collection already exists")
+	settings, err := createSettings(params)
+	if err != nil {
+		respondWithError(w, r, err.Error())
 		return
 	}
 
-	s.Create(collection)
+	if settings == nil {
+		err = s.Create(collection)
+	} else {
+		err = s.CreateWithSettings(collection, *settings)
+	}
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
 	respondWithSuccess(w, r, "collection created")
 }
 
+// createSettings reads the optional `analyzer`, `field_analyzers` and
+// `stop_words` create parameters, eg: `analyzer=text&field_analyzers=sku:keyword,body:html`.
+// `stop_words` is a comma separated list, or `none` to disable stop words.
+// Returns nil if none are set.
+func createSettings(params url.Values) (*search.Settings, error) {
+	settings := &search.Settings{Analyzer: params.Get("analyzer")}
+
+	list := params.Get("field_analyzers")
+	stop := params.Get("stop_words")
+	if list == "" && stop == "" && settings.Analyzer == "" {
+		return nil, nil
+	}
+
+	if stop == "none" {
+		settings.DisableStopWords = true
+	} else if stop != "" {
+		settings.StopWords = strings.Split(stop, ",")
+	}
+
+	if list == "" {
+		return settings, nil
+	}
+
+	settings.Fields = map[string]search.FieldSettings{}
+	for _, pair := range strings.Split(list, ",") {
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("Invalid field_analyzers value: %v, expected field:analyzer", pair)
+		}
+		settings.Fields[parts[0]] = search.FieldSettings{Analyzer: parts[1]}
+	}
+	return settings, nil
+}
+
 // destroy a search engine
 func destroyHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
 	params := r.URL.Query()
@@ -104,12 +232,10 @@ func destroyHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	if !s.Exists(collection) {
-		respondWithError(w, r, "Collection does not exist")
+	if err := s.Destroy(collection); err != nil {
+		respondWithSearchError(w, r, err)
 		return
 	}
-
-	s.Destroy(collection)
 	respondWithSuccess(w, r, "collection destroyed")
 }
 
@@ -123,8 +249,9 @@ func indexHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if !s.Exists(collection) {
-		respondWithError(w, r, "Collection does not exist")
+	version, err := versionParam(params)
+	if err != nil {
+		respondWithError(w, r, "if_version query parameter must be an integer")
 		return
 	}
 
@@ -163,8 +290,65 @@ func indexHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request
 		d.Fields[k] = &search.Field{Value: v}
 	}
 
-	s.Index(collection, d)
-	respondWithSuccess(w, r, "Success, document indexed")
+	res, err := s.IndexIfVersion(collection, d, version)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithVersion(w, r, "Success, document indexed", res.Version)
+}
+
+// merge fields into an existing document
+func updateHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	collection := params.Get("collection")
+
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	version, err := versionParam(params)
+	if err != nil {
+		respondWithError(w, r, "if_version query parameter must be an integer")
+		return
+	}
+
+	bytes, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		respondWithError(w, r, "Error reading body")
+		return
+	}
+
+	if len(bytes) == 0 {
+		respondWithError(w, r, "Error document missing")
+		return
+	}
+
+	var doc documentUpdate
+	err = json.Unmarshal(bytes, &doc)
+	if err != nil {
+		respondWithError(w, r, "Error parsing document JSON")
+		return
+	}
+
+	if len(doc.Id) == 0 {
+		respondWithError(w, r, fmt.Sprintf("Error document id is required, not found in: %v", string(bytes)))
+		return
+	}
+
+	if len(doc.Fields) == 0 {
+		respondWithError(w, r, "Error update is missing fields")
+		return
+	}
+
+	res, err := s.UpdateIfVersion(collection, doc.Id, updateFields(doc.Fields), version)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithVersion(w, r, "Success, document updated", res.Version)
 }
 
 // remove a document from the search engine
@@ -177,8 +361,35 @@ func removeHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if !s.Exists(collection) {
-		respondWithError(w, r, "Collection does not exist")
+	docid := params.Get("docid")
+	if docid == "" {
+		respondWithError(w, r, "docid query parameter is required")
+		return
+	}
+
+	version, err := versionParam(params)
+	if err != nil {
+		respondWithError(w, r, "if_version query parameter must be an integer")
+		return
+	}
+
+	err = s.RemoveIfVersion(collection, docid, version)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithSuccess(w, r, "Document removed")
+}
+
+// return a single stored document
+//
+// ?action=get&collection=foo&docid=xyz
+func getHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	collection := params.Get("collection")
+
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
 		return
 	}
 
@@ -188,8 +399,244 @@ func removeHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	s.Remove(collection, docid)
-	respondWithSuccess(w, r, "Document removed")
+	d, err := s.Get(collection, docid)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+
+	bytes, _ := json.Marshal(newStoredDocument(d))
+	respondWithBody(w, r, string(bytes))
+}
+
+// return the synonym rules of a collection
+//
+// ?action=synonyms&collection=foo
+func synonymsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	collection := r.URL.Query().Get("collection")
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	rules, err := s.Synonyms(collection)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+
+	bytes, _ := json.Marshal(rules)
+	respondWithBody(w, r, string(bytes))
+}
+
+// return the names of every collection
+//
+// ?action=collections
+func collectionsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	bytes, _ := json.Marshal(s.List())
+	respondWithBody(w, r, string(bytes))
+}
+
+// return the collection each alias points to, by alias
+//
+// ?action=aliases
+func aliasesHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	bytes, _ := json.Marshal(s.Aliases())
+	respondWithBody(w, r, string(bytes))
+}
+
+// point an alias at a collection, replacing what it pointed at before
+//
+// POST ?action=set_alias&alias=foo&collection=foo_v2
+func setAliasHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	alias := params.Get("alias")
+	collection := params.Get("collection")
+
+	if alias == "" || collection == "" {
+		respondWithError(w, r, "Alias and collection query parameters are required")
+		return
+	}
+
+	if err := s.SetAlias(alias, collection); err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithSuccess(w, r, "Alias updated")
+}
+
+// POST ?action=remove_alias&alias=foo
+func removeAliasHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	alias := r.URL.Query().Get("alias")
+	if alias == "" {
+		respondWithError(w, r, "Alias query parameter is required")
+		return
+	}
+
+	if err := s.RemoveAlias(alias); err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithSuccess(w, r, "Alias removed")
+}
+
+// return the settings of a collection
+//
+// ?action=settings&collection=foo
+func settingsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	collection := r.URL.Query().Get("collection")
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	settings, err := s.Settings(collection)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+
+	bytes, _ := json.Marshal(settings)
+	respondWithBody(w, r, string(bytes))
+}
+
+// update the settings of a collection. The body is the JSON search.Settings
+// to change, see SearchServer.UpdateSettings.
+//
+// POST ?action=set_settings&collection=foo
+func setSettingsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	collection := r.URL.Query().Get("collection")
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	bytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		respondWithError(w, r, "Error reading body")
+		return
+	}
+
+	if _, err = s.UpdateSettings(collection, bytes); err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithSuccess(w, r, "Settings updated")
+}
+
+// return a page of the term dictionary of a collection. Terms are sorted
+// alphabetically, or by frequency with `sort=frequency`.
+//
+// ?action=terms&collection=foo&prefix=ab&count=50&page=2
+// ?action=terms&collection=foo&sort=frequency&count=10
+func termsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	collection := params.Get("collection")
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	// invalid numbers use the defaults
+	count, _ := strconv.Atoi(params.Get("count"))
+	page, _ := strconv.Atoi(params.Get("page"))
+
+	res, err := s.Terms(collection, search.TermsQuery{
+		Prefix:      params.Get("prefix"),
+		PageSize:    count,
+		Page:        page,
+		ByFrequency: params.Get("sort") == "frequency",
+	})
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+
+	bytes, _ := json.Marshal(res)
+	respondWithBody(w, r, string(bytes))
+}
+
+// re-tokenize every document of a collection in the background, progress is
+// reported by `action=stats`
+//
+// POST ?action=rebuild&collection=foo
+func rebuildHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	collection := r.URL.Query().Get("collection")
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	if err := s.Rebuild(collection); err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithSuccess(w, r, "Rebuild started")
+}
+
+// return the size of a collection, or of every collection by name if none is given
+//
+// ?action=stats&collection=foo
+// ?action=stats
+func statsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	collection := r.URL.Query().Get("collection")
+	if collection == "" {
+		bytes, _ := json.Marshal(s.AllStats())
+		respondWithBody(w, r, string(bytes))
+		return
+	}
+
+	stats, err := s.Stats(collection)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+
+	bytes, _ := json.Marshal(stats)
+	respondWithBody(w, r, string(bytes))
+}
+
+// replace the synonym rules of a collection. The body is a JSON list of
+// search.SynonymRule, or rules in the text format of search.ParseSynonyms.
+//
+// POST ?action=set_synonyms&collection=foo
+func setSynonymsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	collection := r.URL.Query().Get("collection")
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	bytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		respondWithError(w, r, "Error reading body")
+		return
+	}
+
+	rules, err := parseSynonyms(bytes)
+	if err != nil {
+		respondWithError(w, r, err.Error())
+		return
+	}
+
+	if err = s.SetSynonyms(collection, rules); err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+	respondWithSuccess(w, r, "Synonyms updated")
+}
+
+func parseSynonyms(bytes []byte) ([]search.SynonymRule, error) {
+	text := strings.TrimSpace(string(bytes))
+	if !strings.HasPrefix(text, "[") {
+		return search.ParseSynonyms(text)
+	}
+
+	rules := []search.SynonymRule{}
+	if err := json.Unmarshal(bytes, &rules); err != nil {
+		return nil, errors.New("Error parsing synonyms JSON")
+	}
+	return rules, nil
 }
 
 // query a search engine
@@ -212,11 +659,6 @@ func queryHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if !s.Exists(collection) {
-		respondWithError(w, r, "Specified collection does not exist")
-		return
-	}
-
 	// TODO support more complex queries, eg: AND, OR
 
 	query := params.Get("query")
@@ -226,11 +668,12 @@ func queryHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request
 	var count int
 	var page int
 
+	// a count of 0 uses the collections page size
 	countStr := params.Get("count")
-	if countStr == "" {
-		count = search.DefaultPageSize
-	} else if count, err = strconv.Atoi(countStr); err != nil {
-		count = search.DefaultPageSize
+	if countStr != "" {
+		if count, err = strconv.Atoi(countStr); err != nil {
+			count = 0
+		}
 	}
 
 	pageStr := params.Get("page")
@@ -242,20 +685,106 @@ func queryHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request
 
 	fields := params.Get("fields")
 
-	res := s.Query(collection, search.Query{Terms: query, Page: page, PageSize: count, ReturnFields: fields, PartialMatch: partialMatch})
-	resp := map[string]interface{}{}
-	resp["success"] = true
+	res, err := s.Query(collection, search.Query{Terms: query, Page: page, PageSize: count, ReturnFields: fields, PartialMatch: partialMatch})
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+
 	bytes, _ := json.Marshal(res)
-	respondWithBody(w, r, string(bytes))
+	respondWithResults(w, r, string(bytes))
+}
+
+// query a search engine with a JSON search request, see searchRequest
+//
+// POST ?action=search&collection=foo
+func searchHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	collection := params.Get("collection")
+
+	if collection == "" {
+		respondWithError(w, r, "Collection query parameter is required")
+		return
+	}
+
+	bytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		respondWithError(w, r, "Error reading body")
+		return
+	}
+
+	query, err := parseSearchRequest(bytes)
+	if err != nil {
+		respondWithError(w, r, err.Error())
+		return
+	}
+
+	res, err := s.Query(collection, query)
+	if err != nil {
+		respondWithSearchError(w, r, err)
+		return
+	}
+
+	bytes, _ = json.Marshal(res)
+	respondWithResults(w, r, string(bytes))
+}
+
+// versionParam reads the optional `if_version` query parameter. If missing,
+// search.AnyVersion is returned.
+func versionParam(params url.Values) (int, error) {
+	v := params.Get("if_version")
+	if v == "" {
+		return search.AnyVersion, nil
+	}
+	return strconv.Atoi(v)
 }
 
 func respondWithError(w http.ResponseWriter, r *http.Request, msg string) {
+	respondWithErrorCode(w, r, msg, http.StatusBadRequest)
+}
+
+// respondWithSearchError responds with the status code matching an error
+// returned from the search package
+func respondWithSearchError(w http.ResponseWriter, r *http.Request, err error) {
+	respondWithErrorCode(w, r, err.Error(), errorStatus(err))
+}
+
+func errorStatus(err error) int {
+	var persistErr *search.PersistenceError
+	switch {
+	case errors.Is(err, search.ErrCollectionNotFound), errors.Is(err, search.ErrDocumentNotFound),
+		errors.Is(err, search.ErrAliasNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, search.ErrCollectionExists), errors.Is(err, search.ErrVersionConflict),
+		errors.Is(err, search.ErrRebuildInProgress), errors.Is(err, search.ErrCollectionHasAliases):
+		return http.StatusConflict
+	case errors.Is(err, search.ErrInvalidName):
+		return http.StatusBadRequest
+	case errors.As(err, &persistErr):
+		return http.StatusInternalServerError
+	}
+
+	// ErrUnknownAnalyzer and ErrInvalidSettings are wrapped with details, and
+	// fall through to a bad request
+	return http.StatusBadRequest
+}
+
+func respondWithErrorCode(w http.ResponseWriter, r *http.Request, msg string, code int) {
 	resp := map[string]interface{}{}
 	resp["success"] = false
 	resp["msg"] = msg
 	bytes, _ := json.Marshal(resp)
 
-	http.Error(w, string(bytes), 400)
+	http.Error(w, string(bytes), code)
+}
+
+func respondWithVersion(w http.ResponseWriter, r *http.Request, msg string, version int) {
+	resp := map[string]interface{}{}
+	resp["success"] = true
+	resp["msg"] = msg
+	resp["version"] = version
+	bytes, _ := json.Marshal(resp)
+	respondWithBody(w, r, string(bytes))
 }
 
 func respondWithSuccess(w http.ResponseWriter, r *http.Request, msg string) {
@@ -266,13 +795,28 @@ func respondWithSuccess(w http.ResponseWriter, r *http.Request, msg string) {
 	respondWithBody(w, r, string(bytes))
 }
 
-func respondWithBody(w http.ResponseWriter, r *http.Request, body string) {
+// respondWithResults writes query results, which clients can cache for 30 minutes
+func respondWithResults(w http.ResponseWriter, r *http.Request, body string) {
 	t := time.Now()
 	t = t.Add(time.Minute * 30)
 	w.Header().Set("Expires", t.Format(time.RFC1123))
 
+	writeBody(w, r, http.StatusOK, "text/json; charset=utf-8", body)
+}
+
+// respondWithBody writes any other response, eg: stats or settings, which must
+// not be cached as it changes with every write
+func respondWithBody(w http.ResponseWriter, r *http.Request, body string) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	writeBody(w, r, http.StatusOK, "text/json; charset=utf-8", body)
+}
+
+// writeBody writes `body` with the given status code, gzipping it if the
+// client supports it
+func writeBody(w http.ResponseWriter, r *http.Request, status int, contentType string, body string) {
 	// Flag the content type. If GZipped, the browser needs this information
-	w.Header().Set("Content-Type", "text/json; charset=utf-8")
+	w.Header().Set("Content-Type", contentType)
 
 	// Check if the client supports gzip, if so we'll update the response
 	// writer to one that gzip's Write() calls.
@@ -287,6 +831,7 @@ func respondWithBody(w http.ResponseWriter, r *http.Request, body string) {
 		defer gz.Close()
 	}
 
+	w.WriteHeader(status)
 	io.WriteString(g, body)
 }
 
@@ -2,13 +2,17 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"strings"
 	"te/search"
 	"testing"
+	"time"
 )
 
 var collectionName string = "c1"
@@ -129,7 +133,7 @@ func TestIndex(t *testing.T) {
 	}
 
 	// query server
-	res := server.Query(collectionName, search.Query{Terms: "fish"})
+	res, _ := server.Query(collectionName, search.Query{Terms: "fish"})
 	if res.Hits != 1 {
 		t.Error("Http insert failed, could not query for doc after")
 	}
@@ -158,6 +162,83 @@ func TestQuery(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create(collectionName)
+
+	ln := startHttpServer(":10247", server, "")
+	defer ln.Close()
+
+	http.Post("http://localhost:10247?action=index&collection="+collectionName, "text/json", strings.NewReader(fishingDoc))
+
+	update := `{"id": "doc1", "fields": {"title": "Hunting guide", "body": null}}`
+	resp, err := http.Post("http://localhost:10247?action=update&collection="+collectionName, "text/json", strings.NewReader(update))
+
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	if resp.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		t.Error(string(b))
+	}
+
+	if res, _ := server.Query(collectionName, search.Query{Terms: "hunting"}); res.Hits != 1 {
+		t.Error("Http update failed, updated field not found")
+	}
+
+	if res, _ := server.Query(collectionName, search.Query{Terms: "trout"}); res.Hits != 0 {
+		t.Error("Http update failed, removed field still found")
+	}
+
+	update = `{"id": "missing", "fields": {"title": "Hunting guide"}}`
+	resp, _ = http.Post("http://localhost:10247?action=update&collection="+collectionName, "text/json", strings.NewReader(update))
+	if resp.StatusCode == 200 {
+		t.Error("Http update succeeded for a document that does not exist")
+	}
+}
+
+func TestVersionConflict(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create(collectionName)
+
+	ln := startHttpServer(":10248", server, "")
+	defer ln.Close()
+
+	resp, _ := http.Post("http://localhost:10248?action=index&if_version=0&collection="+collectionName, "text/json", strings.NewReader(fishingDoc))
+	if resp.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		t.Error(string(b))
+	}
+
+	resp, _ = http.Post("http://localhost:10248?action=index&if_version=0&collection="+collectionName, "text/json", strings.NewReader(fishingDoc))
+	if resp.StatusCode != 409 {
+		t.Errorf("Expected status 409, got: %v", resp.StatusCode)
+	}
+
+	res, err := http.Get("http://localhost:10248?action=get&docid=doc1&collection=" + collectionName)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	var doc struct {
+		Id      string `json:"id"`
+		Version int    `json:"version"`
+	}
+	bytes, _ := ioutil.ReadAll(res.Body)
+	json.Unmarshal(bytes, &doc)
+
+	if doc.Id != "doc1" || doc.Version != 1 {
+		t.Errorf("Http get failed, got: %v", string(bytes))
+	}
+
+	q := fmt.Sprintf("http://localhost:10248?action=remove&if_version=2&collection=%v&docid=%v", collectionName, "doc1")
+	resp, _ = http.Post(q, "text/json", nil)
+	if resp.StatusCode != 409 {
+		t.Errorf("Expected status 409, got: %v", resp.StatusCode)
+	}
+}
+
 func TestRemove(t *testing.T) {
 	server := search.NewSearchServer()
 	server.Create(collectionName)
@@ -167,7 +248,7 @@ func TestRemove(t *testing.T) {
 
 	resp, err := http.Post("http://localhost:10246?action=index&collection="+collectionName, "text/json", strings.NewReader(fishingDoc))
 
-	res := server.Query(collectionName, search.Query{Terms: "fishing"})
+	res, _ := server.Query(collectionName, search.Query{Terms: "fishing"})
 	if res.Hits == 0 {
 		t.Errorf("Http insert failed, could not query for doc after: %v", res)
 	}
@@ -184,7 +265,7 @@ func TestRemove(t *testing.T) {
 		t.Error(string(b))
 	}
 
-	res = server.Query(collectionName, search.Query{Terms: "fish"})
+	res, _ = server.Query(collectionName, search.Query{Terms: "fish"})
 	if res.Hits > 0 {
 		t.Error("Http remove failed, fish doc still exists")
 	}
@@ -200,3 +281,292 @@ func startHttpServer(addr string, searchServer *search.SearchServer, authToken s
 	go httpServer.Serve(ln)
 	return ln
 }
+
+func TestErrorStatusCodes(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create(collectionName)
+
+	ln := startHttpServer(":10249", server, "")
+	defer ln.Close()
+
+	resp, _ := http.Post("http://localhost:10249?action=create&collection="+collectionName, "text/plain", nil)
+	if resp.StatusCode != 409 {
+		t.Errorf("Expected status 409 creating an existing collection, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Post("http://localhost:10249?action=create&collection=a/b", "text/plain", nil)
+	if resp.StatusCode != 400 {
+		t.Errorf("Expected status 400 for an invalid name, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Get("http://localhost:10249?query=fish&collection=missing")
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 querying a missing collection, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Post("http://localhost:10249?action=remove&docid=x&collection="+collectionName, "text/plain", nil)
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 removing a missing document, got: %v", resp.StatusCode)
+	}
+}
+
+func TestStatsHandler(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create(collectionName)
+	server.Create("empty")
+
+	ln := startRestServer(":10256", server, "")
+	defer ln.Close()
+
+	http.Post("http://localhost:10256?action=index&collection="+collectionName, "text/json", strings.NewReader(fishingDoc))
+
+	resp, _ := http.Get("http://localhost:10256?action=stats&collection=" + collectionName)
+	bytes, _ := ioutil.ReadAll(resp.Body)
+	var stats search.Stats
+	json.Unmarshal(bytes, &stats)
+	if resp.StatusCode != 200 || stats.Documents != 1 || stats.Terms == 0 {
+		t.Errorf("Unexpected stats: %v", string(bytes))
+	}
+	if resp.Header.Get("Cache-Control") != "no-store" || resp.Header.Get("Expires") != "" {
+		t.Errorf("Expected stats not to be cached, got: %v", resp.Header)
+	}
+
+	// only query results can be cached
+	resp, _ = http.Get("http://localhost:10256?collection=" + collectionName + "&query=fishing")
+	if resp.Header.Get("Expires") == "" || resp.Header.Get("Cache-Control") != "" {
+		t.Errorf("Expected query results to expire, got: %v", resp.Header)
+	}
+
+	resp, _ = http.Get("http://localhost:10256?action=stats")
+	bytes, _ = ioutil.ReadAll(resp.Body)
+	all := map[string]search.Stats{}
+	json.Unmarshal(bytes, &all)
+	if len(all) != 2 || all[collectionName].Documents != 1 || all["empty"].Documents != 0 {
+		t.Errorf("Unexpected stats: %v", string(bytes))
+	}
+
+	resp, _ = http.Get("http://localhost:10256/v2/collections/" + collectionName + "/_stats")
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected status 200, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Get("http://localhost:10256?action=stats&collection=missing")
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 for a missing collection, got: %v", resp.StatusCode)
+	}
+}
+
+func TestCollectionSettingsHandlers(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create("b")
+	server.Create("a")
+
+	ln := startRestServer(":10257", server, "password")
+	defer ln.Close()
+
+	resp, _ := http.Get("http://localhost:10257?action=collections")
+	bytes, _ := ioutil.ReadAll(resp.Body)
+	if string(bytes) != `["a","b"]` {
+		t.Errorf("Unexpected collections: %v", string(bytes))
+	}
+
+	resp, _ = http.Post("http://localhost:10257?action=set_settings&collection=a", "", strings.NewReader(`{"page_size": 1}`))
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected status 401 without a token, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Post("http://localhost:10257?action=set_settings&authtoken=password&collection=a", "", strings.NewReader(`{"page_size": 1, "disable_wildcard_queries": true}`))
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected settings to be updated, got: %v", resp.StatusCode)
+	}
+	resp, _ = http.Get("http://localhost:10257?action=settings&collection=a")
+	bytes, _ = ioutil.ReadAll(resp.Body)
+	var settings search.Settings
+	json.Unmarshal(bytes, &settings)
+	if settings.PageSize != 1 || !settings.DisableWildCardQueries {
+		t.Errorf("Unexpected settings: %v", string(bytes))
+	}
+
+	resp, _ = http.Post("http://localhost:10257?action=set_settings&authtoken=password&collection=a", "", strings.NewReader(`{"page_size": -1}`))
+	if resp.StatusCode != 400 {
+		t.Errorf("Expected status 400 for invalid settings, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "GET", "http://localhost:10257/v2/collections", "", "")
+	bytes, _ = ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != 200 || !strings.Contains(string(bytes), `["a","b"]`) {
+		t.Errorf("Unexpected collections: %v", string(bytes))
+	}
+
+	resp = restRequest(t, "PUT", "http://localhost:10257/v2/collections/b/_settings", "password", `{"return_fields": ["title"]}`)
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected settings to be updated, got: %v", resp.StatusCode)
+	}
+	if settings, _ := server.Settings("b"); len(settings.ReturnFields) != 1 {
+		t.Errorf("Unexpected settings: %v", settings)
+	}
+
+	// settings left out of an update keep their values
+	server.SetSettings("b", search.Settings{Analyzer: "text", Synonyms: []search.SynonymRule{{Terms: []string{"sofa", "couch"}}}})
+	resp = restRequest(t, "PUT", "http://localhost:10257/v2/collections/b/_settings", "password", `{"page_size": 3}`)
+	bytes, _ = ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != 200 || !strings.Contains(string(bytes), `"analyzer":"text"`) {
+		t.Errorf("Expected the updated settings, got: %v", string(bytes))
+	}
+	http.Post("http://localhost:10257?action=set_settings&authtoken=password&collection=b", "", strings.NewReader(`{"max_expansions": 10}`))
+	if settings, _ := server.Settings("b"); settings.Analyzer != "text" || len(settings.Synonyms) != 1 || settings.PageSize != 3 || settings.MaxExpansions != 10 {
+		t.Errorf("Expected a partial update to keep the other settings, got: %v", settings)
+	}
+
+	resp = restRequest(t, "GET", "http://localhost:10257/v2/collections/missing/_settings", "", "")
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 for a missing collection, got: %v", resp.StatusCode)
+	}
+}
+
+func TestTermsHandler(t *testing.T) {
+	// a fresh collection and listener every run, so nothing is left from earlier runs
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+	server, _ := search.NewPersistentSearchServer(dir)
+	server.Create(collectionName)
+
+	ts := httptest.NewServer(Handler(server, "password"))
+	defer ts.Close()
+
+	http.Post(ts.URL+"?action=index&authtoken=password&collection="+collectionName, "text/json", strings.NewReader(fishingDoc))
+
+	resp, _ := http.Get(ts.URL + "?action=terms&collection=" + collectionName)
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected status 401 without a token, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Get(ts.URL + "?action=terms&authtoken=password&collection=" + collectionName + "&prefix=t&count=1&page=3")
+	bytes, _ := ioutil.ReadAll(resp.Body)
+	var res search.TermsResult
+	json.Unmarshal(bytes, &res)
+	// this, to, trout, turtl
+	if res.Hits != 4 || len(res.Terms) != 1 || res.Terms[0].Term != "trout" {
+		t.Errorf("Unexpected terms: %v", string(bytes))
+	}
+
+	resp = restRequest(t, "GET", ts.URL+"/v2/collections/"+collectionName+"/_terms?sort=frequency&size=1", "", "")
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected status 401 without a token, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "GET", ts.URL+"/v2/collections/"+collectionName+"/_terms?sort=frequency&size=1", "password", "")
+	bytes, _ = ioutil.ReadAll(resp.Body)
+	json.Unmarshal(bytes, &res)
+	if len(res.Terms) != 1 || res.Terms[0].Term != "fish" || res.Terms[0].Frequency != 2 {
+		t.Errorf("Unexpected terms: %v", string(bytes))
+	}
+}
+
+func TestRebuildHandler(t *testing.T) {
+	server := search.NewSearchServer()
+	server.CreateWithSettings(collectionName, search.Settings{Analyzer: "keyword"})
+	server.Index(collectionName, search.Document{Id: "doc1", Fields: map[string]*search.Field{"title": &search.Field{Value: "Fishing guide"}}})
+	server.SetSettings(collectionName, search.Settings{})
+
+	ln := startRestServer(":10259", server, "password")
+	defer ln.Close()
+
+	resp, _ := http.Post("http://localhost:10259?action=rebuild&collection="+collectionName, "", nil)
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected status 401 without a token, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Post("http://localhost:10259?action=rebuild&authtoken=password&collection="+collectionName, "", nil)
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected the rebuild to start, got: %v", resp.StatusCode)
+	}
+
+	// wait for the background rebuild
+	for i := 0; i < 100; i++ {
+		if stats, _ := server.Stats(collectionName); !stats.Rebuilding {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if res, _ := server.Query(collectionName, search.Query{Terms: "guide"}); res.Hits != 1 {
+		t.Errorf("Expected the collection to be re-tokenized")
+	}
+
+	resp = restRequest(t, "POST", "http://localhost:10259/v2/collections/missing/_rebuild", "password", "")
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 for a missing collection, got: %v", resp.StatusCode)
+	}
+}
+
+func TestAliasHandlers(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create("products_v1")
+	server.Create("products_v2")
+	server.Index("products_v2", search.Document{Id: "doc1", Fields: map[string]*search.Field{"title": &search.Field{Value: "Fishing guide"}}})
+
+	ln := startRestServer(":10260", server, "password")
+	defer ln.Close()
+
+	resp, _ := http.Post("http://localhost:10260?action=set_alias&alias=products&collection=products_v1", "", nil)
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected status 401 without a token, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Post("http://localhost:10260?action=set_alias&authtoken=password&alias=products&collection=products_v1", "", nil)
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected the alias to be set, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "PUT", "http://localhost:10260/v2/aliases/products", "password", `{"collection": "products_v2"}`)
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected the alias to be swapped, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Get("http://localhost:10260?collection=products&query=guide")
+	bytes, _ := ioutil.ReadAll(resp.Body)
+	var res search.SearchResult
+	json.Unmarshal(bytes, &res)
+	if res.Hits != 1 {
+		t.Errorf("Expected the alias to be queried, got: %v", string(bytes))
+	}
+
+	resp, _ = http.Get("http://localhost:10260?action=aliases")
+	bytes, _ = ioutil.ReadAll(resp.Body)
+	if string(bytes) != `{"products":"products_v2"}` {
+		t.Errorf("Unexpected aliases: %v", string(bytes))
+	}
+
+	resp, _ = http.Post("http://localhost:10260?action=destroy&authtoken=password&collection=products_v2", "", nil)
+	if resp.StatusCode != 409 {
+		t.Errorf("Expected status 409 destroying an aliased collection, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "DELETE", "http://localhost:10260/v2/aliases/products", "password", "")
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected the alias to be removed, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Post("http://localhost:10260?action=remove_alias&authtoken=password&alias=products", "", nil)
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 for a missing alias, got: %v", resp.StatusCode)
+	}
+}
+
+func TestWrappedErrorStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{fmt.Errorf("doc 1: %w", search.ErrVersionConflict), 409},
+		{fmt.Errorf("products: %w", search.ErrCollectionNotFound), 404},
+		{fmt.Errorf("save: %w", &search.PersistenceError{Op: "write index", Err: errors.New("disk full")}), 500},
+		{fmt.Errorf("%w: page size", search.ErrInvalidSettings), 400},
+	}
+
+	for _, test := range tests {
+		if status := errorStatus(test.err); status != test.status {
+			t.Errorf("%v: expected status %v, got: %v", test.err, test.status, status)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"te/search"
+)
+
+// The JSON search request accepted by `POST ?action=search` and
+// `POST /v2/collections/{name}/_search`. Example:
+//
+//	{
+//	    "query": "fishing guide",
+//	    "bool": {
+//	        "must": [{"field": "title", "query": "trout"}],
+//	        "should": [{"query": "bass"}, {"bool": {"must": [...]}}],
+//	        "must_not": [{"field": "body", "query": "lake fishing", "phrase": true}]
+//	    },
+//	    "filter": [{"field": "category", "values": ["guides"]}],
+//	    "range": [{"field": "price", "gte": 10, "lt": 20}],
+//	    "sort": [{"field": "price", "order": "desc"}, {"field": "_score"}],
+//	    "page": 1,
+//	    "size": 10,
+//	    "fields": ["title"],
+//	    "highlight": {"fields": ["body"], "pre_tag": "<b>", "post_tag": "</b>"}
+//	}
+type searchRequest struct {
+	Query     string            `json:"query"`
+	Partial   bool              `json:"partial"`
+	Bool      *boolRequest      `json:"bool"`
+	Filter    []filterRequest   `json:"filter"`
+	Range     []rangeRequest    `json:"range"`
+	Sort      []sortRequest     `json:"sort"`
+	Page      int               `json:"page"`
+	Size      int               `json:"size"`
+	Fields    []string          `json:"fields"`
+	Highlight *highlightRequest `json:"highlight"`
+}
+
+type boolRequest struct {
+	Must    []clauseRequest `json:"must"`
+	Should  []clauseRequest `json:"should"`
+	MustNot []clauseRequest `json:"must_not"`
+}
+
+type clauseRequest struct {
+	Field   string       `json:"field"`
+	Query   string       `json:"query"`
+	Phrase  bool         `json:"phrase"`
+	Partial bool         `json:"partial"`
+	Bool    *boolRequest `json:"bool"`
+}
+
+type filterRequest struct {
+	Field  string   `json:"field"`
+	Value  string   `json:"value"`
+	Values []string `json:"values"`
+}
+
+type rangeRequest struct {
+	Field string     `json:"field"`
+	Gt    rangeBound `json:"gt"`
+	Gte   rangeBound `json:"gte"`
+	Lt    rangeBound `json:"lt"`
+	Lte   rangeBound `json:"lte"`
+}
+
+// range bounds can be JSON strings or numbers
+type rangeBound string
+
+type sortRequest struct {
+	Field string `json:"field"`
+	Order string `json:"order"`
+}
+
+type highlightRequest struct {
+	Fields  []string `json:"fields"`
+	PreTag  string   `json:"pre_tag"`
+	PostTag string   `json:"post_tag"`
+}
+
+// max depth of nested bool queries
+const maxBoolDepth = 10
+
+// parseSearchRequest decodes and validates a JSON search request, returning
+// the search.Query it describes. Errors describe the invalid part of the request.
+func parseSearchRequest(body []byte) (search.Query, error) {
+	var req searchRequest
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return search.Query{}, errors.New("search request body is required")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		return search.Query{}, fmt.Errorf("invalid search request: %v", err.Error())
+	}
+
+	return req.toQuery()
+}
+
+func (req *searchRequest) toQuery() (search.Query, error) {
+	q := search.Query{
+		Terms:        req.Query,
+		PartialMatch: req.Partial,
+		Page:         req.Page,
+		PageSize:     req.Size,
+		ReturnFields: strings.Join(req.Fields, "|"),
+	}
+
+	if req.Page < 0 {
+		return q, errors.New("page must be positive")
+	}
+	if req.Size < 0 {
+		return q, errors.New("size must be positive")
+	}
+
+	if req.Bool != nil {
+		b, err := req.Bool.toBool("bool", 1)
+		if err != nil {
+			return q, err
+		}
+		q.Bool = b
+	}
+
+	for i, f := range req.Filter {
+		path := fmt.Sprintf("filter[%v]", i)
+		if f.Field == "" {
+			return q, fmt.Errorf("%v: field is required", path)
+		}
+
+		values := f.Values
+		if f.Value != "" {
+			values = append([]string{f.Value}, values...)
+		}
+		if len(values) == 0 {
+			return q, fmt.Errorf("%v: value or values is required", path)
+		}
+		q.Filters = append(q.Filters, search.Filter{Field: f.Field, Values: values})
+	}
+
+	for i, r := range req.Range {
+		path := fmt.Sprintf("range[%v]", i)
+		if r.Field == "" {
+			return q, fmt.Errorf("%v: field is required", path)
+		}
+		if r.Gt == "" && r.Gte == "" && r.Lt == "" && r.Lte == "" {
+			return q, fmt.Errorf("%v: at least one of gt, gte, lt or lte is required", path)
+		}
+		if r.Gt != "" && r.Gte != "" {
+			return q, fmt.Errorf("%v: only one of gt and gte can be set", path)
+		}
+		if r.Lt != "" && r.Lte != "" {
+			return q, fmt.Errorf("%v: only one of lt and lte can be set", path)
+		}
+		q.Ranges = append(q.Ranges, search.RangeFilter{
+			Field: r.Field,
+			Gt:    string(r.Gt),
+			Gte:   string(r.Gte),
+			Lt:    string(r.Lt),
+			Lte:   string(r.Lte),
+		})
+	}
+
+	for i, s := range req.Sort {
+		path := fmt.Sprintf("sort[%v]", i)
+		if s.Field == "" {
+			return q, fmt.Errorf("%v: field is required", path)
+		}
+
+		var desc bool
+		switch s.Order {
+		case "":
+			// scores sort highest first by default, fields lowest first
+			desc = s.Field == search.SortScore
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return q, fmt.Errorf("%v: order must be asc or desc, got: %v", path, strconv.Quote(s.Order))
+		}
+		q.Sort = append(q.Sort, search.SortField{Field: s.Field, Desc: desc})
+	}
+
+	if req.Highlight != nil {
+		h := req.Highlight
+		if (h.PreTag == "") != (h.PostTag == "") {
+			return q, errors.New("highlight: pre_tag and post_tag must be set together")
+		}
+		q.Highlight = &search.Highlight{Fields: h.Fields, PreTag: h.PreTag, PostTag: h.PostTag}
+	}
+
+	return q, nil
+}
+
+func (b *rangeBound) UnmarshalJSON(data []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err == nil {
+		*b = rangeBound(n.String())
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.New("range bounds must be a string or number")
+	}
+	*b = rangeBound(s)
+	return nil
+}
+
+func (b *boolRequest) toBool(path string, depth int) (*search.BoolQuery, error) {
+	if depth > maxBoolDepth {
+		return nil, fmt.Errorf("%v: bool queries can be nested at most %v deep", path, maxBoolDepth)
+	}
+
+	if len(b.Must) == 0 && len(b.Should) == 0 && len(b.MustNot) == 0 {
+		return nil, fmt.Errorf("%v: at least one of must, should or must_not is required", path)
+	}
+
+	res := &search.BoolQuery{}
+	var err error
+
+	if res.Must, err = toClauses(b.Must, path+".must", depth); err != nil {
+		return nil, err
+	}
+	if res.Should, err = toClauses(b.Should, path+".should", depth); err != nil {
+		return nil, err
+	}
+	if res.MustNot, err = toClauses(b.MustNot, path+".must_not", depth); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func toClauses(list []clauseRequest, path string, depth int) ([]search.Clause, error) {
+	clauses := make([]search.Clause, len(list))
+
+	for i, c := range list {
+		p := fmt.Sprintf("%v[%v]", path, i)
+
+		if c.Bool != nil {
+			if c.Query != "" || c.Field != "" || c.Phrase || c.Partial {
+				return nil, fmt.Errorf("%v: a bool clause can't also set field, query, phrase or partial", p)
+			}
+
+			b, err := c.Bool.toBool(p+".bool", depth+1)
+			if err != nil {
+				return nil, err
+			}
+			clauses[i] = search.Clause{Bool: b}
+			continue
+		}
+
+		if strings.TrimSpace(c.Query) == "" {
+			return nil, fmt.Errorf("%v: query or bool is required", p)
+		}
+		if c.Phrase && c.Partial {
+			return nil, fmt.Errorf("%v: phrase and partial can't be combined", p)
+		}
+
+		clauses[i] = search.Clause{Field: c.Field, Terms: c.Query, Phrase: c.Phrase, PartialMatch: c.Partial}
+	}
+
+	return clauses, nil
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"te/search"
+	"testing"
+)
+
+func TestParseSearchRequest(t *testing.T) {
+	q, err := parseSearchRequest([]byte(`{
+		"query": "guide",
+		"bool": {"should": [{"field": "title", "query": "fishing"}, {"bool": {"must_not": [{"query": "windows"}]}}]},
+		"filter": [{"field": "category", "value": "outdoors"}],
+		"range": [{"field": "price", "gte": 10, "lt": "20"}],
+		"sort": [{"field": "price", "order": "desc"}, {"field": "_score"}],
+		"page": 2,
+		"size": 5,
+		"fields": ["title", "body"],
+		"highlight": {"fields": ["body"]}
+	}`))
+
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if q.Terms != "guide" || q.Page != 2 || q.PageSize != 5 || q.ReturnFields != "title|body" {
+		t.Errorf("Unexpected query: %+v", q)
+	}
+
+	if q.Bool == nil || len(q.Bool.Should) != 2 || q.Bool.Should[1].Bool == nil {
+		t.Errorf("Unexpected bool query: %+v", q.Bool)
+	}
+
+	if len(q.Ranges) != 1 || q.Ranges[0].Gte != "10" || q.Ranges[0].Lt != "20" {
+		t.Errorf("Unexpected ranges: %+v", q.Ranges)
+	}
+
+	if len(q.Sort) != 2 || !q.Sort[0].Desc || !q.Sort[1].Desc {
+		t.Errorf("Unexpected sort: %+v", q.Sort)
+	}
+
+	invalid := map[string]string{
+		`{"bool": {"must": [{}]}}`:                      "bool.must[0]: query or bool is required",
+		`{"bool": {}}`:                                  "bool: at least one of must, should or must_not is required",
+		`{"sort": [{"field": "price", "order": "up"}]}`: `sort[0]: order must be asc or desc, got: "up"`,
+		`{"range": [{"field": "price"}]}`:               "range[0]: at least one of gt, gte, lt or lte is required",
+		`{"filter": [{"value": "x"}]}`:                  "filter[0]: field is required",
+		`{"size": -1}`:                                  "size must be positive",
+		`{"highlight": {"pre_tag": "<b>"}}`:             "highlight: pre_tag and post_tag must be set together",
+		`{"bool": {"must": [{"query": "a", "phrase": true, "partial": true}]}}`: "bool.must[0]: phrase and partial can't be combined",
+	}
+
+	for body, msg := range invalid {
+		_, err := parseSearchRequest([]byte(body))
+		if err == nil || err.Error() != msg {
+			t.Errorf("Expected error %q for %v, got: %v", msg, body, err)
+		}
+	}
+
+	if _, err := parseSearchRequest([]byte(`{"qeury": "x"}`)); err == nil {
+		t.Errorf("Expected an error for an unknown field")
+	}
+}
+
+func TestSearchAction(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create(collectionName)
+
+	ln := startHttpServer(":10252", server, "password")
+	defer ln.Close()
+
+	server.Index(collectionName, search.Document{Id: "doc1", Fields: map[string]*search.Field{"title": &search.Field{Value: "Fishing guide"}}})
+	server.Index(collectionName, search.Document{Id: "doc2", Fields: map[string]*search.Field{"title": &search.Field{Value: "Computer guide"}}})
+
+	body := `{"bool": {"must": [{"query": "guide"}], "must_not": [{"query": "computer"}]}}`
+	resp, err := http.Post("http://localhost:10252?action=search&collection="+collectionName, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var results search.SearchResult
+	bytes, _ := ioutil.ReadAll(resp.Body)
+	json.Unmarshal(bytes, &results)
+
+	if resp.StatusCode != 200 || results.Hits != 1 || results.Documents[0].Id != "doc1" {
+		t.Errorf("Http search failed, got: %v", string(bytes))
+	}
+
+	resp, _ = http.Post("http://localhost:10252?action=search&collection="+collectionName, "application/json", strings.NewReader(`{"sort": [{}]}`))
+	if resp.StatusCode != 400 {
+		t.Errorf("Expected status 400 for an invalid request, got: %v", resp.StatusCode)
+	}
+}
+
+func TestCreateWithAnalyzers(t *testing.T) {
+	server := search.NewSearchServer()
+
+	ln := startHttpServer(":10253", server, "password")
+	defer ln.Close()
+
+	resp, _ := http.Post("http://localhost:10253?action=create&authtoken=password&collection=analyzed&field_analyzers=sku:keyword", "", nil)
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected create to succeed, got: %v", resp.StatusCode)
+	}
+
+	server.Index("analyzed", search.Document{Id: "doc1", Fields: map[string]*search.Field{"sku": &search.Field{Value: "Running"}}})
+	res, _ := server.Query("analyzed", search.Query{Terms: "run"})
+	if res.Hits != 0 {
+		t.Errorf("Expected the sku field not to be stemmed")
+	}
+
+	resp, _ = http.Post("http://localhost:10253?action=create&authtoken=password&collection=other&analyzer=nope", "", nil)
+	if resp.StatusCode != 400 {
+		t.Errorf("Expected status 400 for an unknown analyzer, got: %v", resp.StatusCode)
+	}
+	if server.Exists("other") {
+		t.Errorf("Expected the collection not to be created")
+	}
+}
+
+func TestSynonymsHandler(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create("shop")
+	server.Index("shop", search.Document{Id: "doc1", Fields: map[string]*search.Field{"body": &search.Field{Value: "Cheap television sets"}}})
+
+	ln := startRestServer(":10254", server, "password")
+	defer ln.Close()
+
+	resp, _ := http.Post("http://localhost:10254?action=set_synonyms&collection=shop", "", strings.NewReader("tv, television"))
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected setting synonyms to require auth, got: %v", resp.StatusCode)
+	}
+
+	resp, _ = http.Post("http://localhost:10254?action=set_synonyms&authtoken=password&collection=shop", "", strings.NewReader("tv, television"))
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected set_synonyms to succeed, got: %v", resp.StatusCode)
+	}
+
+	res, _ := server.Query("shop", search.Query{Terms: "tv"})
+	if res.Hits != 1 {
+		t.Errorf("Expected the synonym to match, got: %v", res.Hits)
+	}
+
+	resp, _ = http.Get("http://localhost:10254?action=synonyms&collection=shop")
+	body, _ := ioutil.ReadAll(resp.Body)
+	var rules []search.SynonymRule
+	if err := json.Unmarshal(body, &rules); err != nil || len(rules) != 1 || len(rules[0].Terms) != 2 {
+		t.Errorf("Unexpected synonyms: %s", body)
+	}
+
+	req, _ := http.NewRequest("PUT", "http://localhost:10254/v2/collections/shop/_synonyms", strings.NewReader(`[{"from": ["telly"], "terms": ["television"]}]`))
+	req.Header.Set("Authorization", "Bearer password")
+	resp, _ = http.DefaultClient.Do(req)
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected the REST update to succeed, got: %v", resp.StatusCode)
+	}
+
+	res, _ = server.Query("shop", search.Query{Terms: "telly"})
+	if res.Hits != 1 {
+		t.Errorf("Expected the REST synonyms to apply, got: %v", res.Hits)
+	}
+
+	req, _ = http.NewRequest("PUT", "http://localhost:10254/v2/collections/shop/_synonyms", strings.NewReader(`[{"terms": ["tv"]}]`))
+	req.Header.Set("Authorization", "Bearer password")
+	resp, _ = http.DefaultClient.Do(req)
+	if resp.StatusCode != 400 {
+		t.Errorf("Expected status 400 for an invalid rule, got: %v", resp.StatusCode)
+	}
+}
+
+func TestCreateWithStopWords(t *testing.T) {
+	server := search.NewSearchServer()
+
+	ln := startHttpServer(":10255", server, "")
+	defer ln.Close()
+
+	resp, _ := http.Post("http://localhost:10255?action=create&collection=band&stop_words=none", "", nil)
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected create to succeed, got: %v", resp.StatusCode)
+	}
+
+	server.Index("band", search.Document{Id: "doc1", Fields: map[string]*search.Field{"name": &search.Field{Value: "The Who"}}})
+	server.Index("band", search.Document{Id: "doc2", Fields: map[string]*search.Field{"name": &search.Field{Value: "Who Cares"}}})
+	res, _ := server.Query("band", search.Query{Terms: "the cares"})
+	if res.Hits != 2 {
+		t.Errorf("Expected stop words to be searched for, got: %v", res.Hits)
+	}
+}
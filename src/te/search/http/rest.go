@@ -0,0 +1,478 @@
+package http
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"te/search"
+)
+
+const restPrefix = "/v2/"
+
+// format of documents sent to the REST API, the id is taken from the path
+type restDocument struct {
+	Fields map[string]*string `json:"fields"`
+}
+
+// Handler returns a http.Handler that serves the REST API under `/v2/` and
+// the action based API on every other path
+func Handler(s *search.SearchServer, authToken string) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(restPrefix, RestHandlerFunc(s, authToken))
+	mux.Handle("/", HandlerFunc(s, authToken))
+	return mux
+}
+
+// RestHandlerFunc returns a HTTP handler function serving the REST API for the
+// specified SearchServer. It expects to be mounted at `/v2/`.
+//
+// Unlike the action based API it uses HTTP methods and status codes, takes the
+// auth token in the `Authorization: Bearer <token>` header, and always responds
+// with `application/json`.
+//
+// GET    /v2/collections                     list the collections
+// GET    /v2/collections/{name}              get the settings of a collection
+// PUT    /v2/collections/{name}              create a collection
+// DELETE /v2/collections/{name}              destroy a collection
+// PUT    /v2/collections/{name}/docs/{id}    index a document
+// PATCH  /v2/collections/{name}/docs/{id}    merge fields into a document
+// GET    /v2/collections/{name}/docs/{id}    get a document
+// DELETE /v2/collections/{name}/docs/{id}    remove a document
+// POST   /v2/collections/{name}/_search      query a collection, see searchRequest
+// GET    /v2/collections/{name}/_synonyms    get the synonym rules
+// PUT    /v2/collections/{name}/_synonyms    replace the synonym rules
+// GET    /v2/collections/{name}/_stats       get the size of the collection
+// GET    /v2/collections/{name}/_settings    get the settings
+// PUT    /v2/collections/{name}/_settings    change the settings in the body, others are kept
+// GET    /v2/collections/{name}/_terms       browse the term dictionary, see TermsQuery
+// POST   /v2/collections/{name}/_rebuild     re-tokenize the documents in the background
+// GET    /v2/aliases                         list the aliases and their collections
+// PUT    /v2/aliases/{name}                  point an alias at `{"collection": "name"}`
+// DELETE /v2/aliases/{name}                  remove an alias
+//
+// Collections can be addressed by their aliases, except when destroying them.
+// Document writes accept the optional `if_version` query parameter.
+func RestHandlerFunc(s *search.SearchServer, authToken string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+
+		path, ok := splitRestPath(r.URL)
+		if !ok || (path[0] != "collections" && path[0] != "aliases") {
+			respondWithJSONError(w, r, "Not found", http.StatusNotFound)
+			return
+		}
+
+		// reads are public, same as the action based API, except for the term dictionary
+		isAdmin := len(path) == 3 && path[2] == "_terms"
+		isRead := (r.Method == "GET" && !isAdmin) || (r.Method == "POST" && len(path) == 3 && path[2] == "_search")
+		if authToken != "" && !isRead && bearerToken(r) != authToken {
+			respondWithJSONError(w, r, "Auth token invalid", http.StatusUnauthorized)
+			return
+		}
+
+		if path[0] == "aliases" {
+			restAliasesHandler(s, w, r, path[1:])
+			return
+		}
+
+		if len(path) == 1 {
+			if r.Method != "GET" {
+				methodNotAllowed(w, r, "GET")
+				return
+			}
+			respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"collections": s.List()})
+			return
+		}
+
+		collection := path[1]
+
+		switch {
+		case len(path) == 2:
+			restCollectionHandler(s, w, r, collection)
+		case len(path) == 3 && path[2] == "_search":
+			restSearchHandler(s, w, r, collection)
+		case len(path) == 3 && path[2] == "_synonyms":
+			restSynonymsHandler(s, w, r, collection)
+		case len(path) == 3 && path[2] == "_stats":
+			restStatsHandler(s, w, r, collection)
+		case len(path) == 3 && path[2] == "_settings":
+			restSettingsHandler(s, w, r, collection)
+		case isAdmin:
+			restTermsHandler(s, w, r, collection)
+		case len(path) == 3 && path[2] == "_rebuild":
+			restRebuildHandler(s, w, r, collection)
+		case len(path) == 4 && path[2] == "docs":
+			restDocumentHandler(s, w, r, collection, path[3])
+		default:
+			respondWithJSONError(w, r, "Not found", http.StatusNotFound)
+		}
+	}
+}
+
+// /v2/collections/{name}
+func restCollectionHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string) {
+	switch r.Method {
+	case "GET":
+		settings, err := s.Settings(collection)
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"collection": collection, "settings": settings})
+	case "PUT":
+		// the body is optional, it holds the collections search.Settings
+		bytes, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			respondWithJSONError(w, r, "Failed to read request body", http.StatusBadRequest)
+			return
+		}
+
+		if len(strings.TrimSpace(string(bytes))) == 0 {
+			err = s.Create(collection)
+		} else {
+			var settings search.Settings
+			if err := json.Unmarshal(bytes, &settings); err != nil {
+				respondWithJSONError(w, r, "Invalid collection settings: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			err = s.CreateWithSettings(collection, settings)
+		}
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusCreated, map[string]interface{}{"collection": collection})
+	case "DELETE":
+		if err := s.Destroy(collection); err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"collection": collection})
+	default:
+		methodNotAllowed(w, r, "GET, PUT, DELETE")
+	}
+}
+
+// /v2/collections/{name}/docs/{id}
+func restDocumentHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string, docid string) {
+	switch r.Method {
+	case "GET":
+		d, err := s.Get(collection, docid)
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, newStoredDocument(d))
+	case "PUT", "PATCH":
+		version, err := versionParam(r.URL.Query())
+		if err != nil {
+			respondWithJSONError(w, r, "if_version query parameter must be an integer", http.StatusBadRequest)
+			return
+		}
+
+		bytes, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			respondWithJSONError(w, r, "Error reading body", http.StatusBadRequest)
+			return
+		}
+
+		var doc restDocument
+		if err = json.Unmarshal(bytes, &doc); err != nil {
+			respondWithJSONError(w, r, "Error parsing document JSON", http.StatusBadRequest)
+			return
+		}
+
+		if len(doc.Fields) == 0 {
+			respondWithJSONError(w, r, "Error document is missing fields", http.StatusBadRequest)
+			return
+		}
+
+		var res search.WriteResult
+		if r.Method == "PUT" {
+			d := search.NewDocument()
+			d.Id = docid
+			for k, v := range doc.Fields {
+				if v == nil {
+					respondWithJSONError(w, r, "Error field "+k+" is null", http.StatusBadRequest)
+					return
+				}
+				d.Fields[k] = &search.Field{Value: *v}
+			}
+			res, err = s.IndexIfVersion(collection, d, version)
+		} else {
+			res, err = s.UpdateIfVersion(collection, docid, updateFields(doc.Fields), version)
+		}
+
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+
+		status := http.StatusOK
+		if res.Created {
+			status = http.StatusCreated
+		}
+		respondWithJSON(w, r, status, map[string]interface{}{"id": docid, "version": res.Version})
+	case "DELETE":
+		version, err := versionParam(r.URL.Query())
+		if err != nil {
+			respondWithJSONError(w, r, "if_version query parameter must be an integer", http.StatusBadRequest)
+			return
+		}
+
+		if err = s.RemoveIfVersion(collection, docid, version); err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"id": docid})
+	default:
+		methodNotAllowed(w, r, "GET, PUT, PATCH, DELETE")
+	}
+}
+
+// /v2/collections/{name}/_stats
+func restStatsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string) {
+	if r.Method != "GET" {
+		methodNotAllowed(w, r, "GET")
+		return
+	}
+
+	stats, err := s.Stats(collection)
+	if err != nil {
+		respondWithJSONError(w, r, err.Error(), errorStatus(err))
+		return
+	}
+	respondWithJSON(w, r, http.StatusOK, stats)
+}
+
+// /v2/aliases and /v2/aliases/{name}
+func restAliasesHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, path []string) {
+	if len(path) == 0 {
+		if r.Method != "GET" {
+			methodNotAllowed(w, r, "GET")
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"aliases": s.Aliases()})
+		return
+	}
+	if len(path) > 1 {
+		respondWithJSONError(w, r, "Not found", http.StatusNotFound)
+		return
+	}
+
+	alias := path[0]
+	switch r.Method {
+	case "PUT":
+		bytes, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			respondWithJSONError(w, r, "Error reading body", http.StatusBadRequest)
+			return
+		}
+
+		var body struct {
+			Collection string `json:"collection"`
+		}
+		if err = json.Unmarshal(bytes, &body); err != nil || body.Collection == "" {
+			respondWithJSONError(w, r, "Error alias collection is required", http.StatusBadRequest)
+			return
+		}
+
+		if err = s.SetAlias(alias, body.Collection); err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"alias": alias, "collection": body.Collection})
+	case "DELETE":
+		if err := s.RemoveAlias(alias); err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"alias": alias})
+	default:
+		methodNotAllowed(w, r, "PUT, DELETE")
+	}
+}
+
+// /v2/collections/{name}/_settings
+func restSettingsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string) {
+	switch r.Method {
+	case "GET":
+		settings, err := s.Settings(collection)
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, settings)
+	case "PUT":
+		bytes, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			respondWithJSONError(w, r, "Error reading body", http.StatusBadRequest)
+			return
+		}
+
+		settings, err := s.UpdateSettings(collection, bytes)
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, settings)
+	default:
+		methodNotAllowed(w, r, "GET, PUT")
+	}
+}
+
+// /v2/collections/{name}/_terms?prefix=ab&size=50&page=2&sort=frequency
+func restTermsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string) {
+	if r.Method != "GET" {
+		methodNotAllowed(w, r, "GET")
+		return
+	}
+
+	params := r.URL.Query()
+	size, _ := strconv.Atoi(params.Get("size"))
+	page, _ := strconv.Atoi(params.Get("page"))
+
+	res, err := s.Terms(collection, search.TermsQuery{
+		Prefix:      params.Get("prefix"),
+		PageSize:    size,
+		Page:        page,
+		ByFrequency: params.Get("sort") == "frequency",
+	})
+	if err != nil {
+		respondWithJSONError(w, r, err.Error(), errorStatus(err))
+		return
+	}
+	respondWithJSON(w, r, http.StatusOK, res)
+}
+
+// /v2/collections/{name}/_rebuild
+func restRebuildHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string) {
+	if r.Method != "POST" {
+		methodNotAllowed(w, r, "POST")
+		return
+	}
+
+	if err := s.Rebuild(collection); err != nil {
+		respondWithJSONError(w, r, err.Error(), errorStatus(err))
+		return
+	}
+	respondWithJSON(w, r, http.StatusAccepted, map[string]interface{}{"collection": collection})
+}
+
+// /v2/collections/{name}/_synonyms
+func restSynonymsHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string) {
+	switch r.Method {
+	case "GET":
+		rules, err := s.Synonyms(collection)
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"synonyms": rules})
+	case "PUT":
+		bytes, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			respondWithJSONError(w, r, "Error reading body", http.StatusBadRequest)
+			return
+		}
+
+		rules, err := parseSynonyms(bytes)
+		if err != nil {
+			respondWithJSONError(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err = s.SetSynonyms(collection, rules); err != nil {
+			respondWithJSONError(w, r, err.Error(), errorStatus(err))
+			return
+		}
+		respondWithJSON(w, r, http.StatusOK, map[string]interface{}{"synonyms": rules})
+	default:
+		methodNotAllowed(w, r, "GET, PUT")
+	}
+}
+
+// /v2/collections/{name}/_search
+func restSearchHandler(s *search.SearchServer, w http.ResponseWriter, r *http.Request, collection string) {
+	if r.Method != "POST" {
+		methodNotAllowed(w, r, "POST")
+		return
+	}
+
+	bytes, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		respondWithJSONError(w, r, "Error reading body", http.StatusBadRequest)
+		return
+	}
+
+	query, err := parseSearchRequest(bytes)
+	if err != nil {
+		respondWithJSONError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := s.Query(collection, query)
+	if err != nil {
+		respondWithJSONError(w, r, err.Error(), errorStatus(err))
+		return
+	}
+	respondWithJSON(w, r, http.StatusOK, res)
+}
+
+// splitRestPath returns the unescaped path segments following `/v2/`
+func splitRestPath(u *url.URL) ([]string, bool) {
+	path := u.EscapedPath()
+	i := strings.Index(path, restPrefix)
+	if i == -1 {
+		return nil, false
+	}
+
+	parts := strings.Split(strings.Trim(path[i+len(restPrefix):], "/"), "/")
+	for i, p := range parts {
+		v, err := url.PathUnescape(p)
+		if err != nil || v == "" {
+			return nil, false
+		}
+		parts[i] = v
+	}
+	return parts, true
+}
+
+func bearerToken(r *http.Request) string {
+	h := r.Header.Get("Authorization")
+	if !strings.HasPrefix(h, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(h[len("Bearer "):])
+}
+
+// updateFields converts JSON fields to search fields, null values are kept as
+// nil so they remove the field
+func updateFields(fields map[string]*string) map[string]*search.Field {
+	res := map[string]*search.Field{}
+	for k, v := range fields {
+		if v == nil {
+			res[k] = nil
+		} else {
+			res[k] = &search.Field{Value: *v}
+		}
+	}
+	return res
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request, allow string) {
+	w.Header().Set("Allow", allow)
+	respondWithJSONError(w, r, "Method not allowed", http.StatusMethodNotAllowed)
+}
+
+func respondWithJSONError(w http.ResponseWriter, r *http.Request, msg string, status int) {
+	respondWithJSON(w, r, status, map[string]interface{}{"error": msg})
+}
+
+func respondWithJSON(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	bytes, _ := json.Marshal(v)
+	writeBody(w, r, status, "application/json; charset=utf-8", string(bytes))
+}
@@ -0,0 +1,131 @@
+package http
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"te/search"
+	"testing"
+)
+
+func TestRestDocuments(t *testing.T) {
+	server := search.NewSearchServer()
+	ln := startRestServer(":10250", server, "password")
+	defer ln.Close()
+
+	base := "http://localhost:10250/v2/collections/" + collectionName
+
+	resp := restRequest(t, "PUT", base, "", "")
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected status 401 without auth, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "PUT", base, "password", "")
+	if resp.StatusCode != 201 {
+		t.Errorf("Expected status 201 creating a collection, got: %v", resp.StatusCode)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("Expected application/json, got: %v", ct)
+	}
+
+	resp = restRequest(t, "PUT", base, "password", "")
+	if resp.StatusCode != 409 {
+		t.Errorf("Expected status 409 creating an existing collection, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "PUT", base+"/docs/doc1", "password", `{"fields": {"title": "Fishing guide", "body": "Trout, Bass"}}`)
+	if resp.StatusCode != 201 {
+		t.Errorf("Expected status 201 indexing a new document, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "PATCH", base+"/docs/doc1?if_version=1", "password", `{"fields": {"body": null}}`)
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected status 200 updating a document, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "PATCH", base+"/docs/doc1?if_version=1", "password", `{"fields": {"body": null}}`)
+	if resp.StatusCode != 409 {
+		t.Errorf("Expected status 409 for a stale version, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "GET", base+"/docs/doc1", "", "")
+	var doc struct {
+		Id      string            `json:"id"`
+		Version int               `json:"version"`
+		Fields  map[string]string `json:"fields"`
+	}
+	bytes, _ := ioutil.ReadAll(resp.Body)
+	json.Unmarshal(bytes, &doc)
+
+	if resp.StatusCode != 200 || doc.Version != 2 || len(doc.Fields) != 1 {
+		t.Errorf("Rest get failed, got: %v", string(bytes))
+	}
+
+	resp = restRequest(t, "DELETE", base+"/docs/doc1", "password", "")
+	if resp.StatusCode != 200 {
+		t.Errorf("Expected status 200 removing a document, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "GET", base+"/docs/doc1", "", "")
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 for a removed document, got: %v", resp.StatusCode)
+	}
+
+	resp = restRequest(t, "GET", base+"/_search", "", "")
+	if resp.StatusCode != 405 {
+		t.Errorf("Expected status 405, got: %v", resp.StatusCode)
+	}
+}
+
+func TestRestSearch(t *testing.T) {
+	server := search.NewSearchServer()
+	server.Create(collectionName)
+	ln := startRestServer(":10251", server, "")
+	defer ln.Close()
+
+	http.Post("http://localhost:10251?action=index&collection="+collectionName, "text/json", strings.NewReader(fishingDoc))
+	http.Post("http://localhost:10251?action=index&collection="+collectionName, "text/json", strings.NewReader(computerDoc))
+
+	resp := restRequest(t, "POST", "http://localhost:10251/v2/collections/"+collectionName+"/_search", "", `{"query": "guide", "size": 1, "fields": ["title"]}`)
+
+	var results search.SearchResult
+	bytes, _ := ioutil.ReadAll(resp.Body)
+	json.Unmarshal(bytes, &results)
+
+	if resp.StatusCode != 200 || results.Hits != 2 || len(results.Documents) != 1 {
+		t.Errorf("Rest search failed, got: %v", string(bytes))
+	}
+
+	if len(results.Documents) == 1 && results.Documents[0].Fields["title"] == "" {
+		t.Errorf("Rest search failed, expected title field, got: %v", string(bytes))
+	}
+
+	resp = restRequest(t, "POST", "http://localhost:10251/v2/collections/missing/_search", "", `{"query": "guide"}`)
+	if resp.StatusCode != 404 {
+		t.Errorf("Expected status 404 for a missing collection, got: %v", resp.StatusCode)
+	}
+}
+
+func restRequest(t *testing.T, method string, url string, token string, body string) *http.Response {
+	req, _ := http.NewRequest(method, url, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return resp
+}
+
+// same as startHttpServer, but serves both the REST and action based APIs
+func startRestServer(addr string, searchServer *search.SearchServer, authToken string) net.Listener {
+	httpServer := &http.Server{Addr: addr, Handler: Handler(searchServer, authToken)}
+	ln, _ := net.Listen("tcp", addr)
+	go httpServer.Serve(ln)
+	return ln
+}
@@ -2,6 +2,7 @@ package search
 
 import (
 	"sort"
+	"strings"
 	"sync"
 )
 
@@ -88,6 +89,27 @@ func (i *IndexTable) Remove(t Token, docid int) {
 	i.table[t] = row
 }
 
+// DocFrequency returns the number of documents `t` is in
+func (i *IndexTable) DocFrequency(t Token) int {
+	return len(i.table[t].Docs)
+}
+
+// Prune deletes the row for `t` if no documents contain it. Returns true if
+// the row was deleted.
+func (i *IndexTable) Prune(t Token) bool {
+	row, ok := i.table[t]
+	if !ok || len(row.Docs) > 0 {
+		return false
+	}
+	delete(i.table, t)
+	return true
+}
+
+// Len returns the number of tokens in the index
+func (i *IndexTable) Len() int {
+	return len(i.table)
+}
+
 func (i *IndexTable) Get(t Token) []IndexDoc {
 	row, ok := i.table[t]
 	if ok {
@@ -96,6 +118,32 @@ func (i *IndexTable) Get(t Token) []IndexDoc {
 	return []IndexDoc{}
 }
 
+// GetDoc returns the posting for `docid` in the row for `t`
+func (i *IndexTable) GetDoc(t Token, docid int) (IndexDoc, bool) {
+	docs := i.table[t].Docs
+	idx := sort.Search(len(docs), func(i int) bool { return docs[i].Doc >= docid })
+
+	if idx != len(docs) && docs[idx].Doc == docid {
+		return docs[idx], true
+	}
+	return IndexDoc{}, false
+}
+
+// Terms returns the tokens starting with `prefix` that are in a document,
+// sorted
+func (i *IndexTable) Terms(prefix string) []TermInfo {
+	terms := []TermInfo{}
+	for t, row := range i.table {
+		if len(row.Docs) == 0 || !strings.HasPrefix(string(t), prefix) {
+			continue
+		}
+		terms = append(terms, TermInfo{Term: t, Frequency: row.Frequency, DocFrequency: len(row.Docs)})
+	}
+
+	sort.Slice(terms, func(a, b int) bool { return terms[a].Term < terms[b].Term })
+	return terms
+}
+
 func (d *docSorter) Len() int {
 	return len(d.Docs)
 }
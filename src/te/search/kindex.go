@@ -1,46 +1,103 @@
 package search
 
 import (
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
-// k-gram index
+// k-gram index, eg: for k = 3. `$` marks the start and end of words
 // [$ap] = [apple, apart, ...]
-// [ap] = [apple, map, apart, , ...]
-// [pp] = [apple, mapping, , ...]
+// [app] = [apple, mapping, ...]
+// [le$] = [apple, ...]
 
 // Note: we only support xyx* quries, so the index lacks *xyz indexing. Easy
 // add if we need it the future
-type KGramIndexTable struct {
-	table map[string][]string
-}
+//
+// Tokens are kept in a term dictionary and referenced by id. Each k-gram
+// maps to a sorted list of term ids, so inserts are a binary search and
+// lookups intersect sorted lists.
+type (
+	KGramIndexTable struct {
+		// size of the grams, in runes
+		k int
+		// term dictionary, by token
+		ids map[string]int
+		// terms by id, removed terms leave a gap that is reused
+		terms []kgramTerm
+		free  []int
+		// sorted term ids, by k-gram
+		postings map[string][]int
+	}
+
+	kgramTerm struct {
+		token string
+		// the words the token was added under, eg: `running` for `run`
+		words []string
+	}
+)
+
+// DefaultKGramSize is the k of the k-gram index, see Settings.KGramSize
+const DefaultKGramSize int = 2
 
 func NewKGramIndexTable() KGramIndexTable {
-	return KGramIndexTable{map[string][]string{}}
+	return NewKGramIndexTableSize(DefaultKGramSize)
+}
+
+// NewKGramIndexTableSize returns an index of `k` sized grams
+func NewKGramIndexTableSize(k int) KGramIndexTable {
+	return KGramIndexTable{k: k, ids: map[string]int{}, postings: map[string][]int{}}
 }
 
+// Add indexes the k-grams of `term`, partial matches of it return `token`
 func (i *KGramIndexTable) Add(term string, token string) {
 	if term == "" {
 		return
 	}
 
-	var last string
-	for j, v := range term {
-		var k string
-		s := string(v)
+	id, ok := i.ids[token]
+	if !ok {
+		id = i.newTerm(token)
+	}
 
-		if j == 0 {
-			k = "$" + s
-		} else {
-			k = last + s
+	t := &i.terms[id]
+	for _, w := range t.words {
+		if w == term {
+			return
 		}
+	}
+	t.words = append(t.words, term)
+
+	for _, k := range i.kgrams("$" + term + "$") {
+		i.postings[k] = insertId(i.postings[k], id)
+	}
+}
+
+// Remove deletes `token` and every k-gram posting that references it
+func (i *KGramIndexTable) Remove(token string) {
+	id, ok := i.ids[token]
+	if !ok {
+		return
+	}
 
-		last = s
-		i.append(k, token)
+	for _, w := range i.terms[id].words {
+		for _, k := range i.kgrams("$" + w + "$") {
+			list := removeId(i.postings[k], id)
+			if len(list) == 0 {
+				delete(i.postings, k)
+			} else {
+				i.postings[k] = list
+			}
+		}
 	}
+
+	delete(i.ids, token)
+	i.terms[id] = kgramTerm{}
+	i.free = append(i.free, id)
 }
 
-// returns a list of tokens that match term*
+// returns a list of tokens that match term*. Every token returned was added
+// under a word that starts with `partialTerm`.
 func (i *KGramIndexTable) Get(partialTerm Token) []Token {
 	list := []Token{}
 	term := string(partialTerm)
@@ -49,63 +106,166 @@ func (i *KGramIndexTable) Get(partialTerm Token) []Token {
 		return list
 	}
 
-	// a map[term]bool
-	matching := map[string]bool{}
+	// candidates have every k-gram of the term, as the start of a word
+	prefix := "$" + term
+	lists := [][]int{}
 
-	var last string
-	for j, v := range term {
-		var k string
-		s := string(v)
-
-		if j == 0 {
-			k = "$" + s
-		} else {
-			k = last + s
+	if utf8.RuneCountInString(prefix) < i.k {
+		// the term is shorter than a k-gram, so any k-gram starting with it
+		ids := []int{}
+		for k, list := range i.postings {
+			if strings.HasPrefix(k, prefix) {
+				for _, id := range list {
+					ids = insertId(ids, id)
+				}
+			}
 		}
-
-		last = s
-		terms, ok := i.table[k]
-		if !ok {
-			continue
+		lists = append(lists, ids)
+	} else {
+		for _, k := range i.kgrams(prefix) {
+			ids, ok := i.postings[k]
+			if !ok {
+				return list
+			}
+			lists = append(lists, ids)
 		}
+	}
+
+	// intersect the shortest lists first, the result can only get shorter
+	sort.Slice(lists, func(a, b int) bool { return len(lists[a]) < len(lists[b]) })
+	matching := lists[0]
+	for _, ids := range lists[1:] {
+		matching = intersectIds(matching, ids)
+	}
 
-		clean := map[string]bool{}
-		for _, t := range terms {
-			if j == 0 {
-				clean[t] = true
-			} else if matching[t] {
-				clean[t] = true
+	// the k-grams can match in a different order, or across the words of a
+	// token, so the candidates are checked
+	for _, id := range matching {
+		for _, w := range i.terms[id].words {
+			if strings.HasPrefix(w, term) {
+				list = append(list, Token(i.terms[id].token))
+				break
 			}
 		}
-		matching = clean
 	}
 
-	// iterate over matching and populate list. This makes sure that the found
-	// Token actually starts with the term to avoid false positives
-	for t, _ := range matching {
-		if strings.HasPrefix(t, term) || strings.HasPrefix(term, t) {
-			list = append(list, Token(t))
+	return list
+}
+
+// Len returns the number of terms in the index
+func (i *KGramIndexTable) Len() int {
+	return len(i.ids)
+}
+
+// compact renumbers the terms so there are no gaps left by removed terms
+func (i *KGramIndexTable) compact() {
+	if len(i.free) > 0 {
+		i.load(i.export())
+	}
+}
+
+// resize rebuilds the index with `k` sized grams
+func (i *KGramIndexTable) resize(k int) {
+	if k != i.k {
+		terms := i.export()
+		i.k = k
+		i.load(terms)
+	}
+}
+
+// export returns the words each token was added under, see load
+func (i *KGramIndexTable) export() map[string][]string {
+	res := make(map[string][]string, len(i.ids))
+	for token, id := range i.ids {
+		res[token] = i.terms[id].words
+	}
+	return res
+}
+
+// load replaces the index with the tokens and words returned by export
+func (i *KGramIndexTable) load(terms map[string][]string) {
+	*i = NewKGramIndexTableSize(i.k)
+
+	// sorted so ids are the same every time the index is loaded
+	tokens := make([]string, 0, len(terms))
+	for token := range terms {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	for _, token := range tokens {
+		for _, w := range terms[token] {
+			i.Add(w, token)
 		}
 	}
+}
+
+func (i *KGramIndexTable) newTerm(token string) int {
+	var id int
+	if n := len(i.free); n > 0 {
+		id = i.free[n-1]
+		i.free = i.free[:n-1]
+		i.terms[id] = kgramTerm{token: token}
+	} else {
+		id = len(i.terms)
+		i.terms = append(i.terms, kgramTerm{token: token})
+	}
+
+	i.ids[token] = id
+	return id
+}
+
+// kgrams returns the k-grams of `term`, in runes. Terms shorter than k are
+// a single gram.
+func (i *KGramIndexTable) kgrams(term string) []string {
+	runes := []rune(term)
+	if len(runes) <= i.k {
+		return []string{term}
+	}
+
+	grams := make([]string, 0, len(runes)-i.k+1)
+	for j := 0; j+i.k <= len(runes); j++ {
+		grams = append(grams, string(runes[j:j+i.k]))
+	}
+	return grams
+}
+
+// insertId adds `id` to the sorted list, if its not already in it
+func insertId(list []int, id int) []int {
+	idx := sort.SearchInts(list, id)
+	if idx < len(list) && list[idx] == id {
+		return list
+	}
 
+	list = append(list, 0)
+	copy(list[idx+1:], list[idx:])
+	list[idx] = id
 	return list
 }
 
-func (i *KGramIndexTable) append(kgram string, term string) {
-	table, ok := i.table[kgram]
-	if !ok {
-		table = []string{}
-		i.table[kgram] = table
+// removeId removes `id` from the sorted list
+func removeId(list []int, id int) []int {
+	idx := sort.SearchInts(list, id)
+	if idx == len(list) || list[idx] != id {
+		return list
 	}
+	return append(list[:idx], list[idx+1:]...)
+}
 
-	// only add unique items
-	// TODO if we use a sorted list, we can search much faster
-	for _, v := range table {
-		if v == term {
-			return
+// intersectIds returns the ids in both sorted lists
+func intersectIds(a []int, b []int) []int {
+	res := []int{}
+	for x, y := 0, 0; x < len(a) && y < len(b); {
+		switch {
+		case a[x] < b[y]:
+			x++
+		case a[x] > b[y]:
+			y++
+		default:
+			res = append(res, a[x])
+			x++
+			y++
 		}
 	}
-
-	// add the new item
-	i.table[kgram] = append(table, term)
+	return res
 }
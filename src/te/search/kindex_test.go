@@ -0,0 +1,166 @@
+package search
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestKGramIndexTable(t *testing.T) {
+	k := NewKGramIndexTable()
+	k.Add("apple", "appl")
+	k.Add("apples", "appl")
+	k.Add("apart", "apart")
+	k.Add("map", "map")
+	k.Add("apple", "appl")
+
+	tokens := k.Get("ap")
+	if len(tokens) != 2 || tokens[0] != "appl" || tokens[1] != "apart" {
+		t.Errorf("Unexpected partial matches: %v", tokens)
+	}
+	if len(k.terms[k.ids["appl"]].words) != 2 {
+		t.Errorf("Expected duplicate words to be ignored, got: %v", k.terms[k.ids["appl"]].words)
+	}
+
+	k.Remove("appl")
+	if tokens := k.Get("ap"); len(tokens) != 1 || tokens[0] != "apart" {
+		t.Errorf("Expected the removed term not to match, got: %v", tokens)
+	}
+	if _, ok := k.postings["pl"]; ok {
+		t.Errorf("Expected empty postings to be removed")
+	}
+
+	// removed ids are reused
+	k.Add("apricot", "apricot")
+	if k.ids["apricot"] != 0 || k.Len() != 3 {
+		t.Errorf("Expected the free id to be reused, got: %v", k.ids)
+	}
+
+	loaded := NewKGramIndexTable()
+	loaded.load(k.export())
+	if tokens := loaded.Get("apr"); len(tokens) != 1 || tokens[0] != "apricot" {
+		t.Errorf("Expected the loaded index to match, got: %v", tokens)
+	}
+}
+
+func TestSortedIds(t *testing.T) {
+	list := []int{}
+	for _, id := range []int{5, 1, 3, 3, 9} {
+		list = insertId(list, id)
+	}
+	if len(list) != 4 || list[0] != 1 || list[3] != 9 {
+		t.Errorf("Unexpected list: %v", list)
+	}
+
+	list = removeId(list, 3)
+	list = removeId(list, 4)
+	if len(list) != 3 || list[1] != 5 {
+		t.Errorf("Unexpected list: %v", list)
+	}
+
+	res := intersectIds([]int{1, 2, 5, 7, 9}, []int{2, 3, 7, 10})
+	if len(res) != 2 || res[0] != 2 || res[1] != 7 {
+		t.Errorf("Unexpected intersection: %v", res)
+	}
+}
+
+func TestRemovePrunesTerms(t *testing.T) {
+	s := NewSearchEngine()
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "apple pie"}}})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"title": &Field{Value: "apricot pie"}}})
+
+	s.Remove("1")
+	if _, ok := s.index.table["appl"]; ok {
+		t.Errorf("Expected the empty index row to be removed")
+	}
+	if s.index.DocFrequency("pie") != 1 {
+		t.Errorf("Expected pie to still be indexed")
+	}
+	if res := s.Query(Query{Terms: "ap", PartialMatch: true}); res.Hits != 1 || res.Documents[0].Id != "2" {
+		t.Errorf("Expected the removed word not to be expanded, got: %v", res.Documents)
+	}
+
+	s.Update("2", map[string]*Field{"title": &Field{Value: "cherry pie"}})
+	if _, ok := s.kIndex.ids["apricot"]; ok || s.kIndex.Len() != 2 {
+		t.Errorf("Expected updated words to be pruned, got: %v", s.kIndex.ids)
+	}
+
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"title": &Field{Value: "plum"}}})
+	if s.index.Len() != 1 || s.kIndex.Len() != 1 {
+		t.Errorf("Expected re-indexed words to be pruned, got: %v %v", s.index.table, s.kIndex.ids)
+	}
+
+	// rows left empty by older versions are removed by compaction
+	s.index.Remove("plum", 2)
+	s.Compact()
+	if s.index.Len() != 0 || s.kIndex.Len() != 0 || len(s.kIndex.free) != 0 {
+		t.Errorf("Expected compaction to remove everything, got: %v %v", s.index.table, s.kIndex.ids)
+	}
+}
+
+func TestKGramSize(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 5} {
+		k := NewKGramIndexTableSize(size)
+		k.Add("apple", "appl")
+		k.Add("apples", "appl")
+		k.Add("apart", "apart")
+		k.Add("map", "map")
+		k.Add("a", "a")
+
+		for query, expected := range map[Token]int{"a": 3, "ap": 2, "app": 1, "apples": 1, "applesauce": 0, "pp": 0, "ma": 1} {
+			if tokens := k.Get(query); len(tokens) != expected {
+				t.Errorf("k=%v: Expected %v matches for %v, got: %v", size, expected, query, tokens)
+			}
+		}
+	}
+
+	k := NewKGramIndexTableSize(3)
+	k.Add("apple", "appl")
+	if _, ok := k.postings["le$"]; !ok {
+		t.Errorf("Expected words to end with a marker, got: %v", k.postings)
+	}
+
+	k.resize(2)
+	if _, ok := k.postings["e$"]; !ok || len(k.Get("appl")) != 1 {
+		t.Errorf("Expected the index to be rebuilt, got: %v", k.postings)
+	}
+}
+
+func TestKGramVerification(t *testing.T) {
+	k := NewKGramIndexTableSize(2)
+	// has every bigram of `$abab`, but doesn't start with it
+	k.Add("abba", "abba")
+	k.Add("abab", "abab")
+
+	if tokens := k.Get("abab"); len(tokens) != 1 || tokens[0] != "abab" {
+		t.Errorf("Expected candidates to be verified, got: %v", tokens)
+	}
+
+	// the grams of a token's words can't combine
+	k.Add("ca", "x")
+	k.Add("at", "x")
+	if tokens := k.Get("cat"); len(tokens) != 0 {
+		t.Errorf("Expected no matches, got: %v", tokens)
+	}
+}
+
+func TestKGramUnicode(t *testing.T) {
+	k := NewKGramIndexTableSize(3)
+	k.Add("übermäßig", "übermäßig")
+	k.Add("überall", "überall")
+	k.Add("東京都", "東京都")
+
+	if tokens := k.Get("übe"); len(tokens) != 2 {
+		t.Errorf("Expected 2 matches, got: %v", tokens)
+	}
+	if tokens := k.Get("überm"); len(tokens) != 1 || tokens[0] != "übermäßig" {
+		t.Errorf("Expected 1 match, got: %v", tokens)
+	}
+	if tokens := k.Get("東"); len(tokens) != 1 {
+		t.Errorf("Expected 1 match, got: %v", tokens)
+	}
+	for g := range k.postings {
+		if !utf8.ValidString(g) {
+			t.Errorf("Expected grams to be whole characters, got: %q", g)
+		}
+	}
+}
@@ -0,0 +1,367 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type (
+	// the prose of a markdown document, and its code blocks
+	markdownDocument struct {
+		Text string
+		Code []string
+	}
+
+	// strips markdown syntax, see extractMarkdown
+	markdownStripFilter struct{}
+
+	// a run of emphasis markers, eg: `**`, at `start` in the stripped line
+	delimiterRun struct {
+		start int
+		n     int
+		c     byte
+		open  bool
+		close bool
+	}
+)
+
+// name of the field code blocks are extracted to, see FieldSettings.ExtractCode
+const markdownCodeField string = "code"
+
+func (f markdownStripFilter) Filter(text string) string {
+	return extractMarkdown(text).Text
+}
+
+// extractMarkdown returns the prose of markdown `val`. Code blocks are left
+// out of the text and returned separately, links and images are replaced by
+// their text, and emphasis, heading, quote and list markers are removed.
+// Inline html is left for the html_strip filter.
+func extractMarkdown(val string) markdownDocument {
+	var doc markdownDocument
+	text := []string{}
+
+	// the fence the open code block started with, eg: ```
+	fence := ""
+	code := []string{}
+	prevBlank := true
+	inList := false
+
+	endCode := func() {
+		if len(code) > 0 {
+			doc.Code = append(doc.Code, strings.Join(code, "\n"))
+		}
+		code = code[:0]
+	}
+
+	for _, line := range strings.Split(strings.Replace(val, "\r\n", "\n", -1), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+				fence = ""
+				endCode()
+			} else {
+				code = append(code, line)
+			}
+			continue
+		}
+
+		if f := codeFence(line); f != "" {
+			fence = f
+			prevBlank = false
+			continue
+		}
+
+		// indented code blocks follow a blank line, or more indented code
+		if isIndentedCode(line) && !inList && (prevBlank || len(code) > 0) {
+			code = append(code, strings.TrimPrefix(strings.TrimPrefix(line, "    "), "\t"))
+			prevBlank = false
+			continue
+		}
+		if trimmed != "" {
+			endCode()
+		}
+
+		prevBlank = trimmed == ""
+		if prevBlank {
+			inList = false
+			text = append(text, "")
+			continue
+		}
+
+		if isReferenceDefinition(trimmed) || isHorizontalRule(trimmed) || isTableSeparator(trimmed) {
+			continue
+		}
+
+		// block markers
+		for strings.HasPrefix(trimmed, ">") {
+			trimmed = strings.TrimSpace(trimmed[1:])
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			trimmed = trimHeadingMarkers(trimmed)
+		}
+		if rest, ok := trimListMarker(trimmed); ok {
+			trimmed = rest
+			inList = true
+		}
+
+		text = append(text, markdownInline(strings.Replace(trimmed, "|", " ", -1)))
+	}
+	endCode()
+
+	doc.Text = strings.TrimSpace(strings.Join(text, "\n"))
+	return doc
+}
+
+// markdownInline strips the inline syntax of a line
+func markdownInline(s string) string {
+	var b strings.Builder
+	runs := []delimiterRun{}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_{}[]()#+-.!<>|~", s[i+1]) != -1:
+			b.WriteByte(s[i+1])
+			i += 2
+		case c == '`':
+			// inline code is kept, without the backticks
+			n := 1
+			for i+n < len(s) && s[i+n] == '`' {
+				n++
+			}
+			ticks := s[i : i+n]
+			end := strings.Index(s[i+n:], ticks)
+			if end == -1 {
+				b.WriteString(ticks)
+				i += n
+				continue
+			}
+			b.WriteString(strings.TrimSpace(s[i+n : i+n+end]))
+			i += n + end + n
+		case c == '!' && i+1 < len(s) && s[i+1] == '[':
+			// images are replaced by their alt text
+			if text, n, ok := markdownLink(s[i+1:]); ok {
+				b.WriteString(markdownInline(text))
+				i += 1 + n
+				continue
+			}
+			b.WriteByte(c)
+			i++
+		case c == '[':
+			if text, n, ok := markdownLink(s[i:]); ok {
+				b.WriteString(markdownInline(text))
+				i += n
+				continue
+			}
+			b.WriteByte(c)
+			i++
+		case c == '<':
+			// autolinks are dropped, other html is left as is
+			if end := strings.IndexByte(s[i:], '>'); end != -1 && isAutolink(s[i+1:i+end]) {
+				i += end + 1
+				continue
+			}
+			b.WriteByte(c)
+			i++
+		case c == '*' || c == '_' || (c == '~' && i+1 < len(s) && s[i+1] == '~'):
+			// kept for now, removed below if it opens or closes emphasis
+			n := 1
+			for i+n < len(s) && s[i+n] == c {
+				n++
+			}
+			open, close := delimiterFlanking(s, i, n)
+			runs = append(runs, delimiterRun{start: b.Len(), n: n, c: c, open: open, close: close})
+			b.WriteString(s[i : i+n])
+			i += n
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return stripEmphasis(b.String(), runs)
+}
+
+// delimiterFlanking tests if the run of `n` markers at `i` in `s` can open or
+// close emphasis, by CommonMark's left and right flanking rules. Runs that are
+// both, inside words or between punctuation, are neither, so `2*3*4`,
+// `snake_case` and `src/**/*.go` are kept.
+func delimiterFlanking(s string, i int, n int) (bool, bool) {
+	before, after := ' ', ' '
+	if i > 0 {
+		before, _ = utf8.DecodeLastRuneInString(s[:i])
+	}
+	if i+n < len(s) {
+		after, _ = utf8.DecodeRuneInString(s[i+n:])
+	}
+
+	left := !unicode.IsSpace(after) && (!isMarkdownPunct(after) || unicode.IsSpace(before) || isMarkdownPunct(before))
+	right := !unicode.IsSpace(before) && (!isMarkdownPunct(before) || unicode.IsSpace(after) || isMarkdownPunct(after))
+
+	return left && !right, right && !left
+}
+
+func isMarkdownPunct(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// stripEmphasis removes the runs of `s` that close an earlier run of the same
+// marker, and the runs they close. Markers that aren't paired, eg: `*.go`, are kept.
+func stripEmphasis(s string, runs []delimiterRun) string {
+	strip := make([]bool, len(runs))
+	openers := []int{}
+
+	for k, r := range runs {
+		matched := false
+		if r.close {
+			for j := len(openers) - 1; j >= 0; j-- {
+				if runs[openers[j]].c == r.c {
+					strip[openers[j]], strip[k] = true, true
+					openers = openers[:j]
+					matched = true
+					break
+				}
+			}
+		}
+		if !matched && r.open {
+			openers = append(openers, k)
+		}
+	}
+
+	var b strings.Builder
+	last := 0
+	for k, r := range runs {
+		if strip[k] {
+			b.WriteString(s[last:r.start])
+			last = r.start + r.n
+		}
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// markdownLink reads the link `s` starts with, eg: `[text](url)` or
+// `[text][ref]`. Returns the link text and the length of the link.
+func markdownLink(s string) (string, int, bool) {
+	depth := 0
+	end := -1
+	for i := 0; i < len(s) && end == -1; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		}
+	}
+	if end == -1 {
+		return "", 0, false
+	}
+
+	text := s[1:end]
+	rest := s[end+1:]
+	switch {
+	case strings.HasPrefix(rest, "("):
+		close := strings.IndexByte(rest, ')')
+		if close == -1 {
+			return "", 0, false
+		}
+		return text, end + 1 + close + 1, true
+	case strings.HasPrefix(rest, "["):
+		close := strings.IndexByte(rest, ']')
+		if close == -1 {
+			return "", 0, false
+		}
+		return text, end + 1 + close + 1, true
+	}
+	// shortcut reference links, eg: `[text]`
+	return text, end + 1, true
+}
+
+// codeFence returns the fence `line` opens a code block with, or an empty string
+func codeFence(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return ""
+	}
+
+	for _, f := range []string{"```", "~~~"} {
+		if strings.HasPrefix(trimmed, f) {
+			return f
+		}
+	}
+	return ""
+}
+
+func isIndentedCode(line string) bool {
+	return strings.TrimSpace(line) != "" && (strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t"))
+}
+
+// eg: `## Heading ##`, closing markers need a space before them
+func trimHeadingMarkers(line string) string {
+	line = strings.TrimSpace(strings.TrimLeft(line, "#"))
+	if end := strings.TrimRight(line, "#"); end != line && (end == "" || strings.HasSuffix(end, " ")) {
+		line = strings.TrimSpace(end)
+	}
+	return line
+}
+
+// eg: `[id]: http://example.com "title"`
+func isReferenceDefinition(line string) bool {
+	if !strings.HasPrefix(line, "[") || strings.HasPrefix(line, "[^") {
+		return false
+	}
+	i := strings.Index(line, "]:")
+	return i > 1 && !strings.Contains(line[:i], "]")
+}
+
+// eg: `---`, `* * *`
+func isHorizontalRule(line string) bool {
+	s := strings.Replace(line, " ", "", -1)
+	if len(s) < 3 {
+		return false
+	}
+	return strings.Trim(s, s[:1]) == "" && strings.IndexByte("-*_=", s[0]) != -1
+}
+
+// eg: `| --- | :---: |`
+func isTableSeparator(line string) bool {
+	return strings.Contains(line, "-") && strings.Trim(line, "|-: ") == ""
+}
+
+// trimListMarker removes a list marker, eg: `- `, `1. ` or `- [x] `
+func trimListMarker(line string) (string, bool) {
+	i := 0
+	switch {
+	case len(line) > 1 && strings.IndexByte("-*+", line[0]) != -1 && line[1] == ' ':
+		i = 2
+	default:
+		for i < len(line) && line[i] >= '0' && line[i] <= '9' {
+			i++
+		}
+		if i == 0 || i+1 >= len(line) || (line[i] != '.' && line[i] != ')') || line[i+1] != ' ' {
+			return line, false
+		}
+		i += 2
+	}
+
+	rest := strings.TrimSpace(line[i:])
+	for _, task := range []string{"[ ] ", "[x] ", "[X] "} {
+		rest = strings.TrimPrefix(rest, task)
+	}
+	return rest, true
+}
+
+func isAutolink(s string) bool {
+	if strings.ContainsAny(s, " \t") {
+		return false
+	}
+	i := strings.Index(s, "://")
+	return (i > 0 && isAsciiLetter(s[0])) || (strings.Contains(s, "@") && !strings.HasPrefix(s, "/"))
+}
@@ -0,0 +1,93 @@
+package search
+
+import (
+	"testing"
+)
+
+func TestExtractMarkdown(t *testing.T) {
+	doc := extractMarkdown("# Install ##\n" +
+		"\n" +
+		"Run **go get**, see the [docs](http://example.com/docs \"Docs\") or ![the logo](logo.png).\n" +
+		"Mail <me@example.com> or visit <https://example.com>, use `search_engine` and snake_case _here_.\n" +
+		"\n" +
+		"```go\n" +
+		"s := NewSearchEngine()\n" +
+		"```\n" +
+		"\n" +
+		"    indented code\n" +
+		"\n" +
+		"> - [x] quoted ~~task~~\n" +
+		"1. first [ref][1]\n" +
+		"\n" +
+		"| a | b |\n" +
+		"|---|---|\n" +
+		"---\n" +
+		"[1]: http://example.com\n" +
+		"Learn C#")
+
+	expected := "Install\n" +
+		"\n" +
+		"Run go get, see the docs or the logo.\n" +
+		"Mail  or visit , use search_engine and snake_case here.\n" +
+		"\n" +
+		"\n" +
+		"\n" +
+		"quoted task\n" +
+		"first ref\n" +
+		"\n" +
+		"  a   b  \n" +
+		"Learn C#"
+
+	if doc.Text != expected {
+		t.Errorf("expected: %q\ngot: %q", expected, doc.Text)
+	}
+
+	if len(doc.Code) != 2 || doc.Code[0] != "s := NewSearchEngine()" || doc.Code[1] != "indented code" {
+		t.Errorf("Unexpected code blocks: %q", doc.Code)
+	}
+}
+
+func TestMarkdownAnalyzer(t *testing.T) {
+	s := NewSearchEngine()
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{
+		"readme": {Analyzer: "markdown", ExtractCode: true},
+	}})
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"readme": &Field{Value: "Read the [guide](http://example.com/fishing).\n\n```\nfmt.Println(trout)\n```"},
+	}})
+
+	for _, q := range []string{"example", "http", "println", "trout"} {
+		if res := s.QueryField("readme", q); res.Hits != 0 {
+			t.Errorf("Expected %q not to be indexed as prose", q)
+		}
+	}
+	if res := s.QueryField("readme", "guide"); res.Hits != 1 {
+		t.Errorf("Expected link text to be indexed")
+	}
+	if res := s.QueryField("readme.code", "trout"); res.Hits != 1 {
+		t.Errorf("Expected code blocks to be indexed separately")
+	}
+}
+
+func TestMarkdownEmphasis(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"2*3*4 = 24", "2*3*4 = 24"},
+		{"match *.go and src/**/*.md", "match *.go and src/**/*.md"},
+		{"a * b * c", "a * b * c"},
+		{"snake_case and x_1_2", "snake_case and x_1_2"},
+		{"__init__ is strong", "init is strong"},
+		{"*fish* and **big** ~~old~~ _trout_", "fish and big old trout"},
+		{"(*quoted*), **fish**!", "(quoted), fish!"},
+		{"an \\*escaped\\* star", "an *escaped* star"},
+	}
+
+	for _, test := range tests {
+		if v := markdownInline(test.text); v != test.expected {
+			t.Errorf("%q: expected: %q, got: %q", test.text, test.expected, v)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNGramFilter(t *testing.T) {
+	a, _ := NewAnalyzer("edge_ngram")
+	tokens := a.Tokenize("XJ-4400", false)
+	expected := []Token{"xj", "xj4", "xj44", "xj440", "xj4400", "44", "440", "4400"}
+	if len(tokens) != len(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, tokens)
+	}
+	for i, tok := range expected {
+		if tokens[i] != tok {
+			t.Errorf("Expected %v, got: %v", expected, tokens)
+		}
+	}
+
+	a, _ = NewAnalyzer("ngram")
+	tokens = a.Tokenize("abcd", false)
+	expected = []Token{"ab", "abc", "abcd", "bc", "bcd", "cd"}
+	if len(tokens) != len(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, tokens)
+	}
+
+	// queries are only shortened to the largest gram
+	tokens = a.searchAnalyzer().Tokenize("abcdef", false)
+	if len(tokens) != 1 || tokens[0] != "abcd" {
+		t.Errorf("Expected the query term to be shortened, got: %v", tokens)
+	}
+}
+
+func TestNGramFields(t *testing.T) {
+	s := NewSearchEngine()
+	err := s.SetSettings(Settings{Fields: map[string]FieldSettings{
+		"sku": {NGram: &NGramSettings{Min: 2, Max: 6}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"sku": &Field{Value: "XJ-4400"}}})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"sku": &Field{Value: "xj44 adapter"}}})
+
+	if res := s.Query(Query{Terms: "4400"}); res.Hits != 1 || res.Documents[0].Id != "1" {
+		t.Errorf("Expected a substring match, got: %v", res.Documents)
+	}
+
+	// the whole word match ranks above the gram
+	res := s.Query(Query{Terms: "xj44"})
+	if res.Hits != 2 || res.Documents[0].Id != "2" {
+		t.Errorf("Expected the whole word match first, got: %v", res.Documents)
+	}
+
+	if res := s.QueryField("sku.ngram", "j440"); res.Hits != 1 {
+		t.Errorf("Expected a gram match in the ngram field, got: %v", res.Hits)
+	}
+
+	err = s.SetSettings(Settings{Fields: map[string]FieldSettings{"sku": {NGram: &NGramSettings{Min: 4, Max: 2}}}})
+	if !errors.Is(err, ErrInvalidSettings) {
+		t.Errorf("Expected ErrInvalidSettings, got: %v", err)
+	}
+}
@@ -4,65 +4,62 @@ import (
 	"strings"
 )
 
-// Normailizes puncuation for tokenization. Examples:
-// `car's` becomes `car`
+// Normailizes puncuation of a single word for tokenization. Returns the clean
+// word, and if its a compound word, its parts. Examples:
+// `car's` becomes `car's`, see possessiveFilter
 // `cars'` becomes cars
-// `co-sleep` becomes `sleep` AND `co-sleep`
-// `right-of-way` becomes `right-of-way` AND `right` AND `of` AND `way`
+// `won't` becomes `won't`, see contractionFilter
+// `co-sleep` becomes `co-sleep` with the parts `co` AND `sleep`
+// `right-of-way` becomes `right-of-way` with the parts `right` AND `of` AND `way`
 // Things like !?,. get stripped
-func cleanPunctuation(list []string) []string {
-	var clean []string
-	var compoundWords []string
-
-	for _, t := range list {
-		var compoundWord bool
-		runes := make([]rune, len(t))
-		n := 0
-
-		// TODO if is contraction, replace word with other words
-		// We don't do this now because these are all stripped out as stop words
-		// Once we start supporting stop word searches, this needs to work
-
-		for i, r := range t {
-			// remove standard puncuation
-			if isPunc(r) {
-				continue
-			}
+func cleanWord(t string) (string, []string) {
+	var compoundWord bool
+	runes := make([]rune, len(t))
+	n := 0
+
+	for _, r := range t {
+		// remove standard puncuation
+		if isPunc(r) {
+			continue
+		}
 
-			if isHyphon(r) {
-				compoundWord = true
-			}
+		if isHyphon(r) {
+			compoundWord = true
+		}
 
-			if r == '\'' {
-				// If its the first or second character we don't skip, jsut remove. This catches two cases,
-				// 1) its a qouted word, and the first character is a `'`
-				// 2) Its a name, such as O'Niel
-				// TODO are missing cases here? Its strips everything in all other cases, eg: car's becomes car, abcd'efg becomes abcd
-				if i > 1 {
-					break
-				}
+		if r == '\'' || r == '’' {
+			// If its the first or second character we don't keep it, jsut remove. This catches two cases,
+			// 1) its a qouted word, and the first character is a `'`
+			// 2) Its a name, such as O'Niel
+			// Contractions such as `I'm` are kept whole. In all other cases the
+			// apostrophe stays, eg: abcd'efg
+			if n == 0 || (n == 1 && !isContraction(t)) {
 				continue
 			}
-
-			runes[n] = r
-			n++
+			r = '\''
 		}
 
-		if compoundWord {
-			parts := strings.Split(string(runes[0:n]), "-")
-			if !isCommonPrefix(parts[0]) {
-				compoundWords = append(compoundWords, parts...)
-			} else {
-				compoundWords = append(compoundWords, parts[1:]...)
-			}
-		}
+		runes[n] = r
+		n++
+	}
 
-		if n > 0 {
-			clean = append(clean, string(runes[0:n]))
+	// trailing apostrophes, eg: `bears'`
+	for n > 0 && runes[n-1] == '\'' {
+		n--
+	}
+
+	clean := string(runes[0:n])
+
+	var parts []string
+	if compoundWord && n > 0 {
+		parts = strings.Split(clean, "-")
+		// blank out the prefix, but keep its place so positions line up
+		if isCommonPrefix(parts[0]) {
+			parts[0] = ""
 		}
 	}
 
-	return append(clean, compoundWords...)
+	return clean, parts
 }
 
 func isPunc(c rune) bool {
@@ -77,8 +74,12 @@ func isHyphon(c rune) bool {
 	return c == '-'
 }
 
-func isContraction() bool {
-	return false
+// isContraction tests if `word` is in the contractions table, ignoring case
+// and trailing punctuation
+func isContraction(word string) bool {
+	word = strings.ToLower(strings.TrimRightFunc(word, isPunc))
+	_, ok := contractionTable[strings.Replace(word, "’", "'", -1)]
+	return ok
 }
 
 // if its a commom hyphonated word, such as `co-sleep`, we don't want to index
@@ -91,6 +92,7 @@ func isCommonPrefix(s string) bool {
 	return false
 }
 
+// contractions and the words they expand to, one per line. See contractionTable.
 const contractions string = `aren't,are,not
 can't,cannot
 couldn't,could,not
@@ -144,3 +146,15 @@ you'll,you,will
 you're,you,are
 you've,you,have
 `
+
+// contractions by lower cased contraction
+var contractionTable = parseContractions(contractions)
+
+func parseContractions(text string) map[string][]string {
+	table := map[string][]string{}
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		words := strings.Split(strings.ToLower(line), ",")
+		table[words[0]] = words[1:]
+	}
+	return table
+}
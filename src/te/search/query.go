@@ -0,0 +1,681 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type (
+	// BoolQuery combines clauses. Documents must match all of `Must`, none of
+	// `MustNot`, and if there are no `Must` clauses, at least one `Should`.
+	// Matching `Should` clauses increase the score.
+	BoolQuery struct {
+		Must    []Clause
+		Should  []Clause
+		MustNot []Clause
+	}
+
+	// Clause is a single query in a BoolQuery. Either `Terms` or `Bool` is set.
+	Clause struct {
+		// Field to search, `` searches all fields
+		Field string
+		Terms string
+		// Phrase requires the terms to appear next to each other, in order
+		Phrase       bool
+		PartialMatch bool
+		Bool         *BoolQuery
+	}
+
+	// Filter removes documents whose `Field` does not contain one of `Values`.
+	// Filters do not affect scoring.
+	Filter struct {
+		Field  string
+		Values []string
+	}
+
+	// RangeFilter removes documents whose `Field` value is outside of the range.
+	// Empty bounds are ignored. Values are compared as numbers if both sides are
+	// numeric, otherwise as strings.
+	RangeFilter struct {
+		Field string
+		Gt    string
+		Gte   string
+		Lt    string
+		Lte   string
+	}
+
+	// queryTerm is one term of a query. A document matching any of the
+	// alternatives matches the term, eg: the term and its synonyms.
+	queryTerm struct {
+		alternatives []alternative
+		partial      bool
+	}
+
+	// alternative matches either a token or a phrase, scores are multiplied by weight
+	alternative struct {
+		token  Token
+		phrase []Term
+		weight float64
+	}
+
+	// a token a partial query term is expanded to
+	expansion struct {
+		token  Token
+		weight float64
+	}
+
+	// SortField orders results by a field value. The field `_score` sorts by relevance.
+	SortField struct {
+		Field string
+		Desc  bool
+	}
+
+	// Highlight wraps matched words in the returned fields with `PreTag` and `PostTag`
+	Highlight struct {
+		// Fields to highlight, if empty all fields are highlighted
+		Fields  []string
+		PreTag  string
+		PostTag string
+	}
+
+	// doc id to score
+	scoredDocs map[int]float64
+)
+
+const (
+	// SortScore is the SortField name that sorts by relevance
+	SortScore string = "_score"
+
+	defaultPreTag  string = "<em>"
+	defaultPostTag string = "</em>"
+)
+
+// search runs all parts of `query` and returns the matching docs, sorted
+func (s *SearchEngine) search(query Query) []*hit {
+	clauses := termClauses(query.Terms, query.PartialMatch)
+	if query.Bool != nil {
+		clauses = append(clauses, Clause{Bool: query.Bool})
+	}
+
+	var docs scoredDocs
+	switch {
+	case len(clauses) > 0:
+		docs = s.evalBool(&BoolQuery{Must: clauses})
+	case len(query.Filters) > 0 || len(query.Ranges) > 0:
+		// filter only queries match everything, then filter it down
+		docs = s.allDocs()
+	default:
+		return []*hit{}
+	}
+
+	hits := make([]*hit, 0, len(docs))
+	for docid, score := range docs {
+		d := s.documents[docid]
+		if !s.matchesFilters(d, query.Filters) || !matchesRanges(d, query.Ranges) {
+			continue
+		}
+		hits = append(hits, &hit{doc: docid, score: score})
+	}
+
+	s.sortHits(hits, query.Sort)
+	return hits
+}
+
+// termClauses returns the clauses of a query string. Quoted phrases must
+// match as phrases, the other terms are matched as usual. Eg: `"the who" live`
+// becomes the phrase `the who` and the terms `live`. A quote left open runs
+// to the end of the text.
+func termClauses(text string, partial bool) []Clause {
+	clauses := []Clause{}
+	rest := []string{}
+
+	for i, part := range strings.Split(text, `"`) {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		if i%2 == 1 {
+			clauses = append(clauses, Clause{Terms: part, Phrase: true})
+		} else {
+			rest = append(rest, part)
+		}
+	}
+
+	if len(rest) > 0 {
+		clauses = append(clauses, Clause{Terms: strings.Join(rest, " "), PartialMatch: partial})
+	}
+	return clauses
+}
+
+func (s *SearchEngine) evalBool(b *BoolQuery) scoredDocs {
+	var res scoredDocs
+
+	for i, c := range b.Must {
+		docs := s.evalClause(c)
+		if i == 0 {
+			res = docs
+			continue
+		}
+
+		// intersect, summing the scores
+		for docid, score := range res {
+			if other, ok := docs[docid]; ok {
+				res[docid] = score + other
+			} else {
+				delete(res, docid)
+			}
+		}
+	}
+
+	if len(b.Should) > 0 {
+		should := scoredDocs{}
+		for _, c := range b.Should {
+			for docid, score := range s.evalClause(c) {
+				should[docid] += score
+			}
+		}
+
+		if res == nil {
+			res = should
+		} else {
+			for docid := range res {
+				res[docid] += should[docid]
+			}
+		}
+	}
+
+	// must not queries on their own exclude from everything
+	if res == nil {
+		if len(b.MustNot) == 0 {
+			return scoredDocs{}
+		}
+		res = s.allDocs()
+	}
+
+	for _, c := range b.MustNot {
+		for docid := range s.evalClause(c) {
+			delete(res, docid)
+		}
+	}
+
+	return res
+}
+
+func (s *SearchEngine) evalClause(c Clause) scoredDocs {
+	if c.Bool != nil {
+		return s.evalBool(c.Bool)
+	}
+
+	res := scoredDocs{}
+
+	if c.Phrase {
+		terms := s.analyzer(c.Field).searchAnalyzer().Analyze(c.Terms)
+		for docid, score := range s.phrase(terms, c.Field) {
+			res[docid] = score
+		}
+		return res
+	}
+
+	// If its not a partial match query, remove stop words
+	terms := s.queryTerms(c.Field, c.Terms, !c.PartialMatch, c.PartialMatch)
+	for _, h := range s._all(terms, c.Field) {
+		res[h.doc] = h.score
+	}
+	return res
+}
+
+// queryTerms analyzes `text` for `field` and expands synonyms. If field is “,
+// the terms of every analyzer in use are combined.
+func (s *SearchEngine) queryTerms(field string, text string, stripStopWords bool, partial bool) []queryTerm {
+	analyzers := []*Analyzer{s.analyzer(field)}
+	if field == "" {
+		// n-gram fields are matched by the other analyzers terms
+		used := map[*Analyzer]bool{s.defaultAnalyzer: true}
+		for _, a := range s.fieldAnalyzers {
+			if !used[a] && !a.grams() {
+				used[a] = true
+				analyzers = append(analyzers, a)
+			}
+		}
+	}
+
+	res := []queryTerm{}
+	seen := map[string]bool{}
+
+	for _, a := range analyzers {
+		terms := a.searchAnalyzer().Analyze(text)
+		table := s.synonyms[a]
+		// a query of only stop words, eg: `the who`, is searched for as is
+		strip := stripStopWords && !allStopWords(terms)
+
+		for i := 0; i < len(terms); {
+			if e, n := table.match(terms[i:]); e != nil {
+				source := terms[i : i+n]
+				i += n
+
+				key := termsKey(source)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				qt := queryTerm{partial: partial}
+				qt.alternatives = append(qt.alternatives, newAlternative(source, 1))
+				for _, t := range e.targets {
+					qt.alternatives = append(qt.alternatives, newAlternative(t, synonymWeight))
+				}
+				res = append(res, qt)
+				continue
+			}
+
+			t := terms[i]
+			i++
+
+			if (strip && t.Stop) || seen[t.Value] {
+				continue
+			}
+			seen[t.Value] = true
+
+			res = append(res, queryTerm{
+				alternatives: []alternative{{token: Token(t.Value), weight: 1}},
+				partial:      partial,
+			})
+		}
+	}
+
+	return res
+}
+
+func allStopWords(terms []Term) bool {
+	for _, t := range terms {
+		if !t.Stop {
+			return false
+		}
+	}
+	return true
+}
+
+// newAlternative matches a single token, or the phrase if there is more than one
+func newAlternative(terms []Term, weight float64) alternative {
+	if len(terms) == 1 {
+		return alternative{token: Token(terms[0].Value), weight: weight}
+	}
+	return alternative{phrase: terms, weight: weight}
+}
+
+// matchAlternative returns the docs matching `a`, limited to `field` if set
+func (s *SearchEngine) matchAlternative(a alternative, field string, partial bool) []*hit {
+	hits := []*hit{}
+
+	if a.phrase != nil {
+		// the phrase stands in for a single term, score it by occurrences
+		for docid, score := range s.phrase(a.phrase, field) {
+			hits = append(hits, &hit{doc: docid, score: score / float64(len(a.phrase))})
+		}
+		sort.Slice(hits, func(i, j int) bool { return hits[i].doc < hits[j].doc })
+		return hits
+	}
+
+	tokens := []expansion{{token: a.token, weight: 1}}
+	// partial matches are blended in with the exact matches, weighted lower
+	if partial {
+		tokens = append(tokens, s.expansions(a.token)...)
+	}
+
+	lookup := map[int]*hit{}
+	for _, e := range tokens {
+		for _, d := range s.index.Get(e.token) {
+			if field != "" && !s.fieldContains(d.Doc, field, e.token) {
+				continue
+			}
+
+			score := float64(d.Frequency)
+			if s.boosted {
+				score = s.boostedFrequency(d.Doc, e.token, field)
+			}
+			score *= e.weight
+
+			if h, ok := lookup[d.Doc]; ok {
+				h.score += score
+				continue
+			}
+			h := &hit{doc: d.Doc, score: score}
+			lookup[d.Doc] = h
+			hits = append(hits, h)
+		}
+	}
+	return hits
+}
+
+// expansions returns the tokens `t` is a partial match of, closest first.
+// Weights are below 1, and lower the more the lengths differ. At most
+// Settings.MaxExpansions are returned.
+func (s *SearchEngine) expansions(t Token) []expansion {
+	list := []expansion{}
+	n := float64(utf8.RuneCountInString(string(t)))
+
+	for _, e := range s.kIndex.Get(t) {
+		if e == t {
+			continue
+		}
+
+		m := float64(utf8.RuneCountInString(string(e)))
+		list = append(list, expansion{token: e, weight: partialWeight * math.Min(n, m) / math.Max(n, m)})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].weight != list[j].weight {
+			return list[i].weight > list[j].weight
+		}
+		return list[i].token < list[j].token
+	})
+
+	max := s.settings.MaxExpansions
+	if max == 0 {
+		max = DefaultMaxExpansions
+	}
+	if len(list) > max {
+		list = list[:max]
+	}
+	return list
+}
+
+// phrase returns the docs containing `terms` at the same positions relative
+// to each other as in the query
+func (s *SearchEngine) phrase(terms []Term, field string) scoredDocs {
+	res := scoredDocs{}
+	if len(terms) == 0 {
+		return res
+	}
+
+	first := Token(terms[0].Value)
+	for _, d := range s.index.Get(first) {
+		matches := 0
+
+		for _, p := range s.positions(d.Doc, first, field) {
+			found := true
+			for _, t := range terms[1:] {
+				offset := t.Position - terms[0].Position
+				if !containsInt(s.positions(d.Doc, Token(t.Value), field), p+offset) {
+					found = false
+					break
+				}
+			}
+			if found {
+				matches++
+			}
+		}
+
+		if matches > 0 {
+			res[d.Doc] = float64(matches * len(terms))
+		}
+	}
+
+	return res
+}
+
+// positions returns the positions of `t` in the doc, limited to `field` if set
+func (s *SearchEngine) positions(docid int, t Token, field string) []int {
+	if field == "" {
+		d, _ := s.index.GetDoc(t, docid)
+		return d.Positions
+	}
+
+	f, ok := s.documents[docid].Fields[field]
+	if !ok {
+		return nil
+	}
+	return f.Tokens[t]
+}
+
+// boostedFrequency counts the occurrences of `t` in the doc, multiplied by
+// the boost of the field they are in. Limited to `field` if set.
+func (s *SearchEngine) boostedFrequency(docid int, t Token, field string) float64 {
+	score := 0.0
+	for name, f := range s.documents[docid].Fields {
+		if field == "" || field == name {
+			score += float64(len(f.Tokens[t])) * s.fieldBoost(name)
+		}
+	}
+	return score
+}
+
+func (s *SearchEngine) fieldContains(docid int, field string, t Token) bool {
+	f, ok := s.documents[docid].Fields[field]
+	if !ok {
+		return false
+	}
+
+	_, ok = f.Tokens[t]
+	return ok
+}
+
+func (s *SearchEngine) allDocs() scoredDocs {
+	res := make(scoredDocs, len(s.documents))
+	for docid := range s.documents {
+		res[docid] = 0
+	}
+	return res
+}
+
+// matchesFilters tests if the document matches every filter. A filter matches
+// if the field contains all the tokens of at least one of its values.
+func (s *SearchEngine) matchesFilters(d Document, filters []Filter) bool {
+	for _, filter := range filters {
+		f, ok := d.Fields[filter.Field]
+		if !ok {
+			return false
+		}
+
+		matched := false
+		for _, v := range filter.Values {
+			tokens := s.analyzer(filter.Field).searchAnalyzer().Tokenize(v, false)
+			all := len(tokens) > 0
+			for _, t := range tokens {
+				if _, ok := f.Tokens[t]; !ok {
+					all = false
+					break
+				}
+			}
+			if all {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesRanges(d Document, ranges []RangeFilter) bool {
+	for _, r := range ranges {
+		f, ok := d.Fields[r.Field]
+		if !ok {
+			return false
+		}
+
+		v := strings.TrimSpace(f.Value)
+		if r.Gt != "" && compareValues(v, r.Gt) <= 0 {
+			return false
+		}
+		if r.Gte != "" && compareValues(v, r.Gte) < 0 {
+			return false
+		}
+		if r.Lt != "" && compareValues(v, r.Lt) >= 0 {
+			return false
+		}
+		if r.Lte != "" && compareValues(v, r.Lte) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// compareValues returns -1, 0, or 1. Values are compared as numbers if both
+// are numeric, otherwise as strings.
+func compareValues(a string, b string) int {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+
+	if errA == nil && errB == nil {
+		switch {
+		case fa < fb:
+			return -1
+		case fa > fb:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+// sortHits sorts by the sort fields in order, by default hits are sorted by score.
+// Documents missing a sort field sort last, and ties are broken by document.
+func (s *SearchEngine) sortHits(hits []*hit, fields []SortField) {
+	if len(fields) == 0 {
+		sort.Sort(&hitSorter{hits})
+		return
+	}
+
+	sort.SliceStable(hits, func(a int, b int) bool {
+		for _, sf := range fields {
+			var c int
+			if sf.Field == SortScore {
+				switch {
+				case hits[a].score < hits[b].score:
+					c = -1
+				case hits[a].score > hits[b].score:
+					c = 1
+				}
+			} else {
+				fa, okA := s.documents[hits[a].doc].Fields[sf.Field]
+				fb, okB := s.documents[hits[b].doc].Fields[sf.Field]
+				if !okA || !okB {
+					if okA != okB {
+						return okA
+					}
+					continue
+				}
+				c = compareValues(strings.TrimSpace(fa.Value), strings.TrimSpace(fb.Value))
+			}
+
+			if c == 0 {
+				continue
+			}
+			if sf.Desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		// ties in the order the documents were added, see hitSorter
+		return hits[a].doc < hits[b].doc
+	})
+}
+
+// highlight returns `value` with every word matching one of `tokens` wrapped in the tags
+func highlight(value string, tokens map[Token]bool, h *Highlight, analyzer *Analyzer) string {
+	pre := h.PreTag
+	post := h.PostTag
+	if pre == "" && post == "" {
+		pre = defaultPreTag
+		post = defaultPostTag
+	}
+
+	var b strings.Builder
+	start := -1
+
+	flush := func(end int) {
+		word := value[start:end]
+		matched := false
+		for _, t := range analyzer.Tokenize(word, false) {
+			if tokens[t] {
+				matched = true
+				break
+			}
+		}
+
+		if matched {
+			b.WriteString(pre)
+			b.WriteString(word)
+			b.WriteString(post)
+		} else {
+			b.WriteString(word)
+		}
+		start = -1
+	}
+
+	for i, r := range value {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' || r == '-'
+		if isWord {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+
+		if start != -1 {
+			flush(i)
+		}
+		b.WriteRune(r)
+	}
+
+	if start != -1 {
+		flush(len(value))
+	}
+
+	return b.String()
+}
+
+// highlightTokens returns every token the query searches for
+func (s *SearchEngine) highlightTokens(query Query) map[Token]bool {
+	tokens := map[Token]bool{}
+
+	var add func(c Clause)
+	add = func(c Clause) {
+		if c.Bool != nil {
+			for _, list := range [][]Clause{c.Bool.Must, c.Bool.Should} {
+				for _, c := range list {
+					add(c)
+				}
+			}
+			return
+		}
+
+		if c.Phrase {
+			for _, t := range s.analyzer(c.Field).searchAnalyzer().Tokenize(c.Terms, false) {
+				tokens[t] = true
+			}
+			return
+		}
+
+		for _, qt := range s.queryTerms(c.Field, c.Terms, true, false) {
+			for _, a := range qt.alternatives {
+				if a.phrase == nil {
+					tokens[a.token] = true
+				}
+				for _, t := range a.phrase {
+					tokens[Token(t.Value)] = true
+				}
+			}
+		}
+	}
+
+	for _, c := range termClauses(query.Terms, false) {
+		add(c)
+	}
+	if query.Bool != nil {
+		add(Clause{Bool: query.Bool})
+	}
+	return tokens
+}
+
+func containsInt(list []int, v int) bool {
+	i := sort.SearchInts(list, v)
+	return i < len(list) && list[i] == v
+}
@@ -0,0 +1,191 @@
+package search
+
+import (
+	"fmt"
+	"testing"
+)
+
+func newQueryTestEngine() *SearchEngine {
+	s := NewSearchEngine()
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"title":    &Field{Value: "Fishing guide"},
+		"body":     &Field{Value: "Trout and bass in the lake"},
+		"category": &Field{Value: "outdoors"},
+		"price":    &Field{Value: "15"},
+	},
+	})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{
+		"title":    &Field{Value: "Computer guide"},
+		"body":     &Field{Value: "Windows computers for the lake house"},
+		"category": &Field{Value: "computers"},
+		"price":    &Field{Value: "9.5"},
+	},
+	})
+	s.Index(Document{Id: "3", Fields: map[string]*Field{
+		"title":    &Field{Value: "Bass fishing"},
+		"body":     &Field{Value: "The lake trout guide"},
+		"category": &Field{Value: "outdoors"},
+		"price":    &Field{Value: "120"},
+	},
+	})
+	return s
+}
+
+func ids(res SearchResult) []string {
+	list := []string{}
+	for _, d := range res.Documents {
+		list = append(list, d.Id)
+	}
+	return list
+}
+
+func TestBoolQuery(t *testing.T) {
+	s := newQueryTestEngine()
+
+	res := s.Query(Query{Bool: &BoolQuery{
+		Must:    []Clause{{Terms: "guide"}},
+		MustNot: []Clause{{Terms: "windows"}},
+	}})
+	if res.Hits != 2 {
+		t.Errorf("Expected 2 hits, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Bool: &BoolQuery{
+		Must: []Clause{{Field: "title", Terms: "guide"}},
+	}})
+	if res.Hits != 2 {
+		t.Errorf("Expected 2 hits for a field clause, got: %v", ids(res))
+	}
+
+	// nested: guide AND (windows OR bass)
+	res = s.Query(Query{Terms: "guide", Bool: &BoolQuery{
+		Must: []Clause{{Bool: &BoolQuery{
+			Should: []Clause{{Terms: "windows"}, {Field: "title", Terms: "bass"}},
+		}}},
+	}})
+	if res.Hits != 2 {
+		t.Errorf("Expected 2 hits for a nested query, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Bool: &BoolQuery{MustNot: []Clause{{Terms: "trout"}}}})
+	if res.Hits != 1 || res.Documents[0].Id != "2" {
+		t.Errorf("Expected only doc 2 for a must not query, got: %v", ids(res))
+	}
+}
+
+func TestPhraseQuery(t *testing.T) {
+	s := newQueryTestEngine()
+
+	res := s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Terms: "lake trout", Phrase: true}}}})
+	if res.Hits != 1 || res.Documents[0].Id != "3" {
+		t.Errorf("Expected only doc 3 for phrase, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Terms: "trout and bass", Phrase: true}}}})
+	if res.Hits != 1 || res.Documents[0].Id != "1" {
+		t.Errorf("Expected only doc 1 for phrase with stop word, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Field: "title", Terms: "lake trout", Phrase: true}}}})
+	if res.Hits != 0 {
+		t.Errorf("Expected no hits for phrase in title, got: %v", ids(res))
+	}
+}
+
+func TestFiltersRangesAndSort(t *testing.T) {
+	s := newQueryTestEngine()
+
+	res := s.Query(Query{Terms: "guide", Filters: []Filter{{Field: "category", Values: []string{"outdoors"}}}})
+	if res.Hits != 2 {
+		t.Errorf("Expected 2 filtered hits, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Ranges: []RangeFilter{{Field: "price", Gte: "10", Lt: "100"}}})
+	if res.Hits != 1 || res.Documents[0].Id != "1" {
+		t.Errorf("Expected doc 1 for the range, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Terms: "lake", Sort: []SortField{{Field: "price", Desc: true}}})
+	list := ids(res)
+	if len(list) != 3 || list[0] != "3" || list[1] != "1" || list[2] != "2" {
+		t.Errorf("Expected docs sorted by price desc, got: %v", list)
+	}
+}
+
+func TestPagingThroughTies(t *testing.T) {
+	s := NewSearchEngine()
+	for i := 0; i < 30; i++ {
+		s.Index(Document{Id: fmt.Sprint(i), Fields: map[string]*Field{
+			"title":    &Field{Value: "same guide"},
+			"category": &Field{Value: "books"},
+		},
+		})
+	}
+
+	for _, sort := range [][]SortField{nil, {{Field: "category"}}} {
+		seen := map[string]bool{}
+		for page := 1; page <= 3; page++ {
+			for _, id := range ids(s.Query(Query{Terms: "guide", Page: page, PageSize: 10, Sort: sort})) {
+				if seen[id] {
+					t.Errorf("Expected pages not to overlap, %v was on two pages", id)
+				}
+				seen[id] = true
+			}
+		}
+		if len(seen) != 30 {
+			t.Errorf("Expected every document once, got: %v", len(seen))
+		}
+
+		if first := ids(s.Query(Query{Terms: "guide", PageSize: 1, Sort: sort})); first[0] != "0" {
+			t.Errorf("Expected ties in the order they were added, got: %v", first)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	s := newQueryTestEngine()
+
+	res := s.Query(Query{Terms: "fish", Highlight: &Highlight{Fields: []string{"title"}}})
+	if res.Hits != 2 {
+		t.Errorf("Expected 2 hits, got: %v", ids(res))
+		return
+	}
+
+	for _, d := range res.Documents {
+		if d.Id == "1" && d.Highlights["title"] != "<em>Fishing</em> guide" {
+			t.Errorf("Unexpected highlight: %v", d.Highlights["title"])
+		}
+		if _, ok := d.Highlights["body"]; ok {
+			t.Errorf("Unexpected highlight of body field")
+		}
+	}
+}
+
+func TestPartialMatchBlending(t *testing.T) {
+	s := NewSearchEngine()
+	for id, title := range map[string]string{"1": "application", "2": "apple", "3": "app", "4": "map"} {
+		s.Index(Document{Id: id, Fields: map[string]*Field{"title": &Field{Value: title}}})
+	}
+
+	// exact matches first, then the closest expansions
+	res := s.Query(Query{Terms: "app", PartialMatch: true})
+	expected := []string{"3", "2", "1"}
+	if len(res.Documents) != len(expected) {
+		t.Fatalf("Expected %v, got: %v", expected, res.Documents)
+	}
+	for i, id := range expected {
+		if res.Documents[i].Id != id {
+			t.Errorf("Expected %v, got: %v", expected, res.Documents)
+		}
+	}
+
+	if res := s.Query(Query{Terms: "app"}); res.Hits != 1 {
+		t.Errorf("Expected no expansions without partial matching, got: %v", res.Hits)
+	}
+
+	s.SetSettings(Settings{MaxExpansions: 1})
+	res = s.Query(Query{Terms: "app", PartialMatch: true})
+	if res.Hits != 2 || res.Documents[1].Id != "2" {
+		t.Errorf("Expected only the closest expansion, got: %v", res.Documents)
+	}
+}
@@ -0,0 +1,187 @@
+package search
+
+import (
+	"strings"
+)
+
+// fields added by extractFields, by the name after the `.`
+var extractedFields = map[string]bool{
+	htmlTitleField:       true,
+	htmlDescriptionField: true,
+	htmlHeadingsField:    true,
+	markdownCodeField:    true,
+	ngramField:           true,
+}
+
+// Rebuild re-tokenizes every stored document with the current settings into
+// a new index, for when analyzers or stop words have changed. Queries and
+// writes continue against the old index while it is built, then it is
+// swapped in and the collection is saved. Returns ErrRebuildInProgress if a
+// rebuild is already running.
+func (s *SearchEngine) Rebuild() error {
+	r, err := s.startRebuild()
+	if err != nil {
+		return err
+	}
+	return s.finishRebuild(r)
+}
+
+// StartRebuild is Rebuild, but returns once the rebuild has started. Its
+// progress and errors are reported by Stats.
+func (s *SearchEngine) StartRebuild() error {
+	r, err := s.startRebuild()
+	if err != nil {
+		return err
+	}
+	go s.finishRebuild(r)
+	return nil
+}
+
+// startRebuild returns a new engine, with the settings of `s` and a copy of
+// its documents, to build the index in
+func (s *SearchEngine) startRebuild() (*SearchEngine, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.rebuilding != nil {
+		return nil, ErrRebuildInProgress
+	}
+	s.rebuilding = map[int]bool{}
+	s.rebuildError = nil
+
+	r := s.rebuildEngine()
+	for uid, d := range s.documents {
+		r.documents[uid] = d
+	}
+	return r, nil
+}
+
+func (s *SearchEngine) rebuildEngine() *SearchEngine {
+	r := NewSearchEngine()
+	r.kIndex = NewKGramIndexTableSize(s.kIndex.k)
+	r.settings = s.settings
+	r.settingsVersion = s.settingsVersion
+	r.defaultAnalyzer = s.defaultAnalyzer
+	r.fieldAnalyzers = s.fieldAnalyzers
+	r.SupportWildCardQuries = s.SupportWildCardQuries
+	return r
+}
+
+// finishRebuild indexes the documents of `r`, then swaps its index into `s`
+// and saves it
+func (s *SearchEngine) finishRebuild(r *SearchEngine) error {
+	// the documents are replaced as they are indexed
+	docs := make([]Document, 0, len(r.documents))
+	for _, d := range r.documents {
+		docs = append(docs, d)
+	}
+	for _, d := range docs {
+		r.reindex(d)
+	}
+
+	// saved without holding the lock, so queries and writes aren't blocked
+	err := s.saveRebuild(s.swapRebuild(r))
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.rebuilding = nil
+	s.rebuildError = err
+	return err
+}
+
+// swapRebuild catches `r` up with the writes made while it was built, and
+// swaps its index into `s`. Returns the documents to save.
+func (s *SearchEngine) swapRebuild(r *SearchEngine) []Document {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if r.settingsVersion != s.settingsVersion {
+		// the settings changed while building, so every document is out of date
+		r = s.rebuildEngine()
+		for _, d := range s.documents {
+			r.reindex(d)
+		}
+	} else {
+		// catch up with the documents written while building
+		for uid := range s.rebuilding {
+			if d, ok := r.documents[uid]; ok {
+				r.unindex(d)
+			}
+			if d, ok := s.documents[uid]; ok {
+				r.reindex(d)
+			}
+		}
+	}
+
+	s.index.table = r.index.table
+	s.kIndex = r.kIndex
+	s.documents = r.documents
+	// writes from now on go to the new index and save themselves, they are
+	// tracked so saveRebuild doesn't overwrite them
+	s.rebuilding = map[int]bool{}
+
+	docs := make([]Document, 0, len(s.documents))
+	for _, d := range s.documents {
+		docs = append(docs, d)
+	}
+	return docs
+}
+
+// saveRebuild saves the re-tokenized `docs` and the index. Each document is
+// saved under the read lock, unless it was written since the swap.
+func (s *SearchEngine) saveRebuild(docs []Document) error {
+	if !s.persistent {
+		return nil
+	}
+
+	for _, d := range docs {
+		if err := s.saveRebuiltDocument(d); err != nil {
+			return err
+		}
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.writeIndexToDisk()
+}
+
+func (s *SearchEngine) saveRebuiltDocument(d Document) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.rebuilding[d.Uid] {
+		return nil
+	}
+	return s.writeDocumentToDisk(d)
+}
+
+// reindex tokenizes `doc` from its field values, and adds it to the index.
+// Fields extracted by earlier settings are dropped and extracted again.
+func (s *SearchEngine) reindex(doc Document) {
+	fields := make(map[string]*Field, len(doc.Fields))
+	for name, f := range doc.Fields {
+		if !isExtractedField(name, doc.Fields) {
+			fields[name] = &Field{Value: f.Value}
+		}
+	}
+	doc.Fields = fields
+
+	s.tokenizeDocument(&doc)
+	s.addToInverseIndex(doc, true)
+	if s.SupportWildCardQuries {
+		s.addToKgramIndex(doc.Fields)
+	}
+	s.documents[doc.Uid] = doc
+}
+
+// isExtractedField returns true if `name` was extracted from another of the
+// `fields`, eg: `body.title` from `body`
+func isExtractedField(name string, fields map[string]*Field) bool {
+	i := strings.LastIndex(name, ".")
+	if i <= 0 || !extractedFields[name[i+1:]] {
+		return false
+	}
+	_, ok := fields[name[:i]]
+	return ok
+}
@@ -0,0 +1,126 @@
+package search
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestRebuild(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+
+	s, _ := NewPersistentSearchEngine(dir)
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{"body": {Analyzer: "keyword"}}})
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"body": &Field{Value: "<h1>Running</h1> shoes"}}})
+
+	if s.QueryField("body", "running").Hits != 0 {
+		t.Errorf("Expected the keyword analyzer to be used")
+	}
+
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{"body": {Analyzer: "html", ExtractHTML: true}}})
+	if s.QueryField("body", "running").Hits != 0 {
+		t.Errorf("Expected documents not to be re-tokenized by settings")
+	}
+
+	if err := s.Rebuild(); err != nil {
+		t.Fatal(err)
+	}
+	if s.QueryField("body", "run").Hits != 1 || s.QueryField("body.headings", "run").Hits != 1 {
+		t.Errorf("Expected the document to be re-tokenized")
+	}
+	if _, ok := s.index.table["<h1>running</h1> shoes"]; ok {
+		t.Errorf("Expected the old tokens to be removed, got: %v", s.index.table)
+	}
+	if s.Query(Query{Terms: "sho", PartialMatch: true}).Hits != 1 {
+		t.Errorf("Expected the k-gram index to be rebuilt")
+	}
+
+	// extracted fields are extracted again, not kept
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{"body": {Analyzer: "html"}}})
+	s.Rebuild()
+	if d, _ := s.Get("1"); len(d.Fields) != 1 || d.Version != 1 {
+		t.Errorf("Expected the extracted fields to be removed, got: %v", d)
+	}
+
+	s, _ = NewPersistentSearchEngine(dir)
+	if s.QueryField("body", "run").Hits != 1 || s.Stats().Rebuilding {
+		t.Errorf("Expected the rebuilt index to be saved")
+	}
+}
+
+func TestRebuildWithWrites(t *testing.T) {
+	s := NewSearchEngine()
+	for _, id := range []string{"1", "2", "3"} {
+		s.Index(Document{Id: id, Fields: map[string]*Field{"title": &Field{Value: "cat " + id}}})
+	}
+
+	r, err := s.startRebuild()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Rebuild(); err != ErrRebuildInProgress || !s.Stats().Rebuilding {
+		t.Errorf("Expected a rebuild to be in progress, got: %v", err)
+	}
+
+	// written while the new index is built
+	s.Remove("1")
+	s.Update("2", map[string]*Field{"title": &Field{Value: "dog 2"}})
+	s.Index(Document{Id: "4", Fields: map[string]*Field{"title": &Field{Value: "cat 4"}}})
+	if s.Query(Query{Terms: "cat"}).Hits != 2 {
+		t.Errorf("Expected queries to use the old index")
+	}
+
+	if err := s.finishRebuild(r); err != nil {
+		t.Fatal(err)
+	}
+	if res := s.Query(Query{Terms: "cat"}); res.Hits != 2 || s.Query(Query{Terms: "dog"}).Hits != 1 {
+		t.Errorf("Expected the writes to be in the new index, got: %v", res.Documents)
+	}
+	if s.index.DocFrequency("cat") != 2 || len(s.documents) != 3 {
+		t.Errorf("Unexpected index: %v", s.index.table)
+	}
+
+	// settings changed while building
+	r, _ = s.startRebuild()
+	s.SetSettings(Settings{Analyzer: "keyword"})
+	s.finishRebuild(r)
+	if s.Query(Query{Terms: "cat 3"}).Hits != 1 || s.index.DocFrequency("cat") != 0 {
+		t.Errorf("Expected the latest settings to be used, got: %v", s.index.table)
+	}
+}
+
+func TestRebuildSaveWithWrites(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+
+	s, _ := NewPersistentSearchEngine(dir)
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "cat"}}})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"title": &Field{Value: "cat"}}})
+
+	// only the field analyzers change while building
+	r, _ := s.startRebuild()
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{"title": {Analyzer: "keyword"}}})
+	r.reindex(r.documents[s.externalToInternalId["1"]])
+	docs := s.swapRebuild(r)
+	if s.index.DocFrequency("cat") != 2 {
+		t.Errorf("Expected the latest settings to be used, got: %v", s.index.table)
+	}
+
+	// written after the swap, but before the rebuild is saved
+	s.Update("1", map[string]*Field{"title": &Field{Value: "dog"}})
+	if !s.Stats().Rebuilding {
+		t.Errorf("Expected the rebuild to run until it is saved")
+	}
+	if err := s.saveRebuild(docs); err != nil {
+		t.Fatal(err)
+	}
+
+	s, _ = NewPersistentSearchEngine(dir)
+	if d, _ := s.Get("1"); d.Fields["title"].Value != "dog" {
+		t.Errorf("Expected the write not to be overwritten by the rebuild, got: %v", d)
+	}
+	if s.QueryField("title", "dog").Hits != 1 || s.QueryField("title", "cat").Hits != 1 {
+		t.Errorf("Expected the rebuilt index to be saved")
+	}
+}
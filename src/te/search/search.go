@@ -5,15 +5,23 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
 var DefaultPageSize int = 20
 
+// positions skipped between fields, so phrases don't match across them
+const fieldPositionGap int = 100
+
+// AnyVersion disables the version check of the *IfVersion methods
+const AnyVersion int = -1
+
 const (
-	defaultSavePath string = "/te_search_data/%v"
+	defaultSavePath string = "/te_search_data"
 	defaultSaveName string = "__default_collection"
 	indexFileName   string = "_index"
 )
@@ -28,6 +36,21 @@ type (
 		// docid to doc
 		documents            map[int]Document
 		externalToInternalId map[string]int
+		lock                 sync.RWMutex
+		settings             Settings
+		// counts settings changes, so a rebuild can tell they changed while it ran
+		settingsVersion int
+		defaultAnalyzer *Analyzer
+		fieldAnalyzers  map[string]*Analyzer
+		synonyms        map[*Analyzer]synonymTable
+		// if fields have boosts, see SearchEngine.fieldBoost
+		boosted bool
+		// when a document was last changed, see SearchEngine.Stats
+		updated time.Time
+		// documents changed during a rebuild, or while it's saved, nil if one
+		// isn't running. See SearchEngine.Rebuild
+		rebuilding   map[int]bool
+		rebuildError error
 		// wild card quries can be disabled on an engine level. If disabled, the index
 		// never gets created, resulting in less memory usage.
 		SupportWildCardQuries bool
@@ -40,16 +63,29 @@ type (
 		Documents []DocResult `json:"documents"`
 	}
 
+	// WriteResult describes a document write, see SearchEngine.IndexIfVersion
+	WriteResult struct {
+		// the version of the document after the write
+		Version int
+		// true if the write added a new document
+		Created bool
+	}
+
 	DocResult struct {
-		Id     string            `json:"id"`
-		Fields map[string]string `json:"fields,omitempty"`
+		Id         string            `json:"id"`
+		Version    int               `json:"version"`
+		Score      float64           `json:"score"`
+		Fields     map[string]string `json:"fields,omitempty"`
+		Highlights map[string]string `json:"highlights,omitempty"`
 	}
 
 	Document struct {
 		// internal id
 		Uid int
 		// external id
-		Id          string            `json:"id"`
+		Id string `json:"id"`
+		// incremented every time the document changes, used for optimistic concurrency
+		Version     int               `json:"version"`
 		Fields      map[string]*Field `json:"fields"`
 		DateAdded   time.Time         `json:"dateAdded"`
 		DateUpdated time.Time         `json:"dateUpdated"`
@@ -62,15 +98,24 @@ type (
 	}
 
 	Query struct {
-		// a query string of terms
+		// a query string of terms. Quoted terms must match as a phrase, eg: `"the who"`
 		Terms string
 		// Fields to search, can be `` to mean all or `field1|field2|field3`
 		// SearchFields string
-		// Fields to return, can be `` to mean all or `field1|field2|field3`
+		// Fields to return, `field1|field2|field3`. If empty the collections
+		// Settings.ReturnFields are returned, by default only the id is.
 		ReturnFields string
 		PageSize     int
 		Page         int
 		PartialMatch bool
+		// Bool is an optional boolean query. If Terms is also set, both must match.
+		Bool *BoolQuery
+		// Filters and Ranges restrict results without affecting scoring
+		Filters []Filter
+		Ranges  []RangeFilter
+		// Sort orders the results, by default they are sorted by score
+		Sort      []SortField
+		Highlight *Highlight
 	}
 
 	/*
@@ -81,8 +126,8 @@ type (
 	*/
 
 	hit struct {
-		doc  int
-		freq int
+		doc   int
+		score float64
 	}
 
 	hitSorter struct {
@@ -93,8 +138,11 @@ type (
 	engineJsonExport struct {
 		ExternalToInternalId map[string]int
 		Index                map[Token]IndexRow
-		KIndex               map[string][]string
-		NextIndex            int
+		// k-gram rows of indexes saved before KTerms, only read
+		KIndex map[string][]string `json:",omitempty"`
+		// k-gram terms and the words they were added under
+		KTerms    map[string][]string
+		NextIndex int
 	}
 )
 
@@ -113,46 +161,62 @@ func NewSearchEngine() *SearchEngine {
 	s.documents = map[int]Document{}
 	s.externalToInternalId = map[string]int{}
 	s.SupportWildCardQuries = true
+	s.defaultAnalyzer = NewStandardAnalyzer()
+	s.fieldAnalyzers = map[string]*Analyzer{}
 	return s
 }
 
-func NewPersistentSearchEngine(savePath string) *SearchEngine {
+// NewPersistentSearchEngine creates a search engine that saves to, and restores
+// from `savePath`. A PersistenceError is returned if previous data could not be loaded.
+func NewPersistentSearchEngine(savePath string) (*SearchEngine, error) {
 	if savePath == "" {
-		savePath = fmt.Sprintf(defaultSavePath, defaultSaveName)
+		savePath = filepath.Join(defaultSavePath, defaultSaveName)
 	}
 
 	s := NewSearchEngine()
-	s.SetPersistent(true, savePath)
-	return s
+	err := s.SetPersistent(true, savePath)
+	return s, err
 }
 
 // savePath _must_ be unique per database. If not, multiple databases
 // we restore from and write to the same files, over-writing each other.
-func (s *SearchEngine) SetPersistent(persistent bool, savePath string) {
+func (s *SearchEngine) SetPersistent(persistent bool, savePath string) error {
 	s.persistent = persistent
 	s.savePath = savePath
 
 	if persistent {
 		// make sure pathh exists
-		os.MkdirAll(savePath, 0770)
+		err := os.MkdirAll(savePath, 0770)
+		if err != nil {
+			return &PersistenceError{Op: "create directory", Path: savePath, Err: err}
+		}
 		// load any previous data
-		s.readIndexFromDisk()
+		if err := s.readSettingsFromDisk(); err != nil {
+			return err
+		}
+		return s.readIndexFromDisk()
 	}
+	return nil
 }
 
 func (s *SearchEngine) Query(query Query) SearchResult {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
 	if query.Page < 1 {
 		query.Page = 1
 	}
+	if query.PageSize == 0 {
+		query.PageSize = s.settings.PageSize
+	}
 	if query.PageSize == 0 {
 		query.PageSize = DefaultPageSize
 	}
+	if query.ReturnFields == "" {
+		query.ReturnFields = strings.Join(s.settings.ReturnFields, "|")
+	}
 
-	tokenizer := NewSimpleTokenizer()
-
-	// If its not a partial match query, remove stop words
-	tokens := tokenizer.Tokenize(query.Terms, !query.PartialMatch)
-	docs := s._all(tokens, query.PartialMatch)
+	docs := s.search(query)
 
 	// lookup documents
 	results := newSearchResult()
@@ -179,10 +243,28 @@ func (s *SearchEngine) Query(query Query) SearchResult {
 		returnFields[f] = true
 	}
 
+	var highlightTokens map[Token]bool
+	highlightFields := map[string]bool{}
+	if query.Highlight != nil {
+		highlightTokens = s.highlightTokens(query)
+		for _, f := range query.Highlight.Fields {
+			highlightFields[f] = true
+		}
+	}
+
 	for i := 0; i < count; i++ {
 		docid := docs[start+i].doc
 		doc := s.documents[docid]
-		res := DocResult{Id: doc.Id, Fields: map[string]string{}}
+		res := DocResult{Id: doc.Id, Version: doc.Version, Score: docs[start+i].score, Fields: map[string]string{}}
+
+		if query.Highlight != nil {
+			res.Highlights = map[string]string{}
+			for k, v := range doc.Fields {
+				if len(highlightFields) == 0 || highlightFields[k] {
+					res.Highlights[k] = highlight(v.Value, highlightTokens, query.Highlight, s.analyzer(k))
+				}
+			}
+		}
 
 		// only return fields explicitly asked for. By default only id is returned.
 		if query.ReturnFields != "" {
@@ -201,109 +283,367 @@ func (s *SearchEngine) Query(query Query) SearchResult {
 }
 
 func (s *SearchEngine) QueryField(field string, query string) SearchResult {
-	tokenizer := NewSimpleTokenizer()
-	tokens := tokenizer.Tokenize(query, false)
-	docs := s._all(tokens, false)
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 
-	// lookup documents, filter to only include matching fields
+	docs := s._all(s.queryTerms(field, query, false, false), field)
+
+	// lookup documents, only docs with matches in the field are returned
 	results := newSearchResult()
 	results.Page = 1
 	results.PageSize = DefaultPageSize
 
 	for _, doc := range docs {
 		d := s.documents[doc.doc]
-		// We are basically indexing per-field here, why not just make the indexing
-		// global, and we can skip the initial `all` query. It will probably be smaller than
-		// what we are currently doing, lots of duplication now
-		f, ok := d.Fields[field]
-		if !ok {
-			continue
-		}
+		res := DocResult{Id: d.Id, Version: d.Version, Fields: map[string]string{}}
 
-		for _, t := range tokens {
-			_, ok := f.Tokens[t]
-			if ok {
-				res := DocResult{Id: d.Id, Fields: map[string]string{}}
-
-				for k, v := range d.Fields {
-					res.Fields[k] = v.Value
-				}
-
-				results.Documents = append(results.Documents, res)
-			}
+		for k, v := range d.Fields {
+			res.Fields[k] = v.Value
 		}
+
+		results.Documents = append(results.Documents, res)
 	}
 
 	results.Hits = len(results.Documents)
 	return results
 }
 
-// Remove purges the given document from the index
-func (s *SearchEngine) Remove(docid string) {
+// Get returns the stored document with the external id `docid`
+func (s *SearchEngine) Get(docid string) (Document, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
 	uid, ok := s.externalToInternalId[docid]
-	if ok {
-		d := s.documents[uid]
-		// remove the document from all tokens
-		for _, f := range d.Fields {
-			for t, _ := range f.Tokens {
-				s.index.Remove(t, uid)
-			}
+	if !ok {
+		return Document{}, false
+	}
+	doc, ok := s.documents[uid]
+	return doc, ok
+}
+
+// Remove purges the given document from the index. Returns ErrDocumentNotFound
+// if the document does not exist.
+func (s *SearchEngine) Remove(docid string) error {
+	return s.RemoveIfVersion(docid, AnyVersion)
+}
+
+// RemoveIfVersion purges the given document from the index if its current
+// version is `version`. Returns ErrVersionConflict if it is not.
+func (s *SearchEngine) RemoveIfVersion(docid string, version int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	uid, ok := s.externalToInternalId[docid]
+	if !ok {
+		return ErrDocumentNotFound
+	}
+
+	if err := s.checkVersion(docid, version); err != nil {
+		return err
+	}
+
+	s.unindex(s.documents[uid])
+	delete(s.externalToInternalId, docid)
+	s.touch(uid, time.Now())
+
+	if s.persistent {
+		if err := s.removeDocumentFromDisk(uid); err != nil {
+			return err
 		}
-		delete(s.documents, uid)
 	}
+	return s.writeIndexToDisk()
 }
 
 // Index adds a document to the index based on the `terms`.
 // If `docid` already exists in the index, it is updated.
 // `data` is the value returned when searching.
-func (s *SearchEngine) Index(doc Document) {
+// A PersistenceError is returned if the document could not be saved.
+func (s *SearchEngine) Index(doc Document) error {
+	_, err := s.IndexIfVersion(doc, AnyVersion)
+	return err
+}
+
+// IndexIfVersion indexes `doc` if the current version of the document is
+// `version`. Use 0 to only index documents that don't exist yet. Returns
+// ErrVersionConflict if the versions don't match. The result holds the new
+// version, and is also returned with persistence errors, as the write has
+// already been applied in memory.
+func (s *SearchEngine) IndexIfVersion(doc Document, version int) (WriteResult, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.checkVersion(doc.Id, version); err != nil {
+		return WriteResult{}, err
+	}
+
 	// get/set the documentes internal id
 	uid, exists := s.externalToInternalId[doc.Id]
 	if !exists {
 		uid = s.index.NextIndex()
 		doc.Uid = uid
 		doc.DateAdded = time.Now()
+		doc.Version = 0
 		s.externalToInternalId[doc.Id] = uid
+	} else {
+		prev := s.documents[uid]
+		doc.DateAdded = prev.DateAdded
+		doc.Version = prev.Version
 	}
 
 	doc.Uid = uid
 	doc.DateUpdated = time.Now()
+	doc.Version++
 
-	lastPos := 0
-	tokenizer := NewSimpleTokenizer()
-	for _, f := range doc.Fields {
-		f.Tokens, lastPos = tokenizer.TokenizeWithPositions(f.Value, lastPos)
-	}
+	s.tokenizeDocument(&doc)
 
 	// add to the inverse index
 	s.addToInverseIndex(doc, !exists)
+	if exists {
+		s.prune(tokenSet(s.documents[uid]))
+	}
 
 	// add the document to the kgram index. This one is
 	// opt in because it results in a large memory increase
 	if s.SupportWildCardQuries {
-		s.addToKgramIndex(doc)
+		s.addToKgramIndex(doc.Fields)
 	}
 
 	// save the document for later retrieval
 	s.documents[uid] = doc
+	s.touch(uid, doc.DateUpdated)
+	res := WriteResult{Version: doc.Version, Created: !exists}
 
 	// write the document to disk
 	if s.persistent {
-		docJson, err := json.Marshal(doc)
-		if err != nil {
-			panic(fmt.Sprintf("Failed to save indexed file to disk, err: %v", err.Error()))
+		if err := s.writeDocumentToDisk(doc); err != nil {
+			return res, err
 		}
-		ioutil.WriteFile(fmt.Sprintf("%v/%v", s.savePath, uid), docJson, 0770)
 	}
 
 	// write the updated index to disk
-	s.writeIndexToDisk()
+	return res, s.writeIndexToDisk()
 }
 
-func (s *SearchEngine) addToInverseIndex(doc Document, isNew bool) {
-	// all tokens in the document
-	tokens := map[Token][]int{}
+// tokenizeDocument adds the extracted fields of `doc`, see extractFields, and
+// tokenizes every field
+func (s *SearchEngine) tokenizeDocument(doc *Document) {
+	doc.Fields = s.extractFields(doc.Fields)
+
+	// empty extracted html fields
+	for name, f := range doc.Fields {
+		if f == nil {
+			delete(doc.Fields, name)
+		}
+	}
+
+	// fields are positioned in name order, so updates can lay them out the same way
+	lastPos := 0
+	for _, name := range fieldNames(doc.Fields) {
+		f := doc.Fields[name]
+		f.Tokens, lastPos = s.analyzer(name).TokenizeWithPositions(f.Value, lastPos)
+		lastPos += fieldPositionGap
+	}
+}
+
+func fieldNames(fields map[string]*Field) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fieldStarts returns the position each field was tokenized after, laid out
+// from its stored tokens the same way as tokenizeDocument
+func fieldStarts(fields map[string]*Field) map[string]int {
+	starts := make(map[string]int, len(fields))
+	pos := 0
+	for _, name := range fieldNames(fields) {
+		starts[name] = pos
+		pos = lastPosition(fields[name].Tokens, pos) + fieldPositionGap
+	}
+	return starts
+}
+
+// lastPosition returns the highest position in `tokens`, or `start` if there
+// are none after it
+func lastPosition(tokens map[Token][]int, start int) int {
+	last := start
+	for _, positions := range tokens {
+		if n := len(positions); n > 0 && positions[n-1] > last {
+			last = positions[n-1]
+		}
+	}
+	return last
+}
+
+// shiftPositions returns a copy of `tokens` with every position moved by `delta`
+func shiftPositions(tokens map[Token][]int, delta int) map[Token][]int {
+	shifted := make(map[Token][]int, len(tokens))
+	for t, positions := range tokens {
+		moved := make([]int, len(positions))
+		for i, p := range positions {
+			moved[i] = p + delta
+		}
+		shifted[t] = moved
+	}
+	return shifted
+}
+
+// unindex removes `doc` from the index and the stored documents
+func (s *SearchEngine) unindex(doc Document) {
+	// remove the document from all tokens
+	for _, f := range doc.Fields {
+		for t := range f.Tokens {
+			s.index.Remove(t, doc.Uid)
+		}
+	}
+	s.prune(tokenSet(doc))
+	delete(s.documents, doc.Uid)
+}
+
+// touch records that document `uid` changed at `t`
+func (s *SearchEngine) touch(uid int, t time.Time) {
+	s.updated = t
+	if s.rebuilding != nil {
+		s.rebuilding[uid] = true
+	}
+}
+
+// Update merges `fields` into the existing document `docid`. Fields not
+// included are left untouched, fields set to nil are removed from the document.
+// Only the index rows of changed fields, and of fields whose positions moved,
+// are updated. Returns ErrDocumentNotFound if the document does not exist.
+func (s *SearchEngine) Update(docid string, fields map[string]*Field) error {
+	_, err := s.UpdateIfVersion(docid, fields, AnyVersion)
+	return err
+}
+
+// UpdateIfVersion is Update, but only if the current version of the document
+// is `version`. Returns ErrDocumentNotFound if the document does not exist and
+// ErrVersionConflict if the versions don't match.
+func (s *SearchEngine) UpdateIfVersion(docid string, fields map[string]*Field, version int) (WriteResult, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	uid, ok := s.externalToInternalId[docid]
+	if !ok {
+		return WriteResult{}, ErrDocumentNotFound
+	}
+
+	if err := s.checkVersion(docid, version); err != nil {
+		return WriteResult{}, err
+	}
+
+	prev := s.documents[uid]
+	doc := prev
+	doc.DateUpdated = time.Now()
+	doc.Version++
+	doc.Fields = make(map[string]*Field, len(prev.Fields))
+	for k, f := range prev.Fields {
+		doc.Fields[k] = f
+	}
+
+	// tokens from both the old and new version of every changed field, and of
+	// fields whose positions moved. These are the only index rows that can be
+	// affected by the update
+	changed := map[Token]bool{}
+	updated := map[string]*Field{}
+
+	fields = s.extractFields(fields)
+
+	for k, f := range fields {
+		if old, ok := prev.Fields[k]; ok {
+			for t := range old.Tokens {
+				changed[t] = true
+			}
+		}
+
+		if f == nil {
+			delete(doc.Fields, k)
+			continue
+		}
+
+		nf := &Field{Value: f.Value}
+		doc.Fields[k] = nf
+		updated[k] = nf
+	}
 
+	// positions are laid out from the start of the document again, the same
+	// as tokenizeDocument, so they don't grow with every update. Only the
+	// updated fields are tokenized, the fields after them are moved by however
+	// much the updated fields grew or shrank.
+	starts := fieldStarts(prev.Fields)
+	lastPos := 0
+	for _, k := range fieldNames(doc.Fields) {
+		f := doc.Fields[k]
+
+		if _, ok := updated[k]; ok {
+			var end int
+			f.Tokens, end = s.analyzer(k).TokenizeWithPositions(f.Value, lastPos)
+			lastPos = end + fieldPositionGap
+			for t := range f.Tokens {
+				changed[t] = true
+			}
+			continue
+		}
+
+		delta := lastPos - starts[k]
+		lastPos = lastPosition(f.Tokens, starts[k]) + delta + fieldPositionGap
+		if delta == 0 {
+			continue
+		}
+
+		for t := range f.Tokens {
+			changed[t] = true
+		}
+		doc.Fields[k] = &Field{Value: f.Value, Tokens: shiftPositions(f.Tokens, delta)}
+	}
+
+	touched := make(map[Token]bool, len(changed))
+	for t := range changed {
+		touched[t] = true
+	}
+	s.updateInverseIndex(doc, prev, changed)
+	s.prune(touched)
+
+	if s.SupportWildCardQuries {
+		s.addToKgramIndex(updated)
+	}
+
+	s.documents[uid] = doc
+	s.touch(uid, doc.DateUpdated)
+	res := WriteResult{Version: doc.Version}
+
+	if s.persistent {
+		if err := s.writeDocumentToDisk(doc); err != nil {
+			return res, err
+		}
+	}
+
+	return res, s.writeIndexToDisk()
+}
+
+// checkVersion returns ErrVersionConflict if the current version of `docid`
+// is not `version`. Documents that don't exist are version 0.
+func (s *SearchEngine) checkVersion(docid string, version int) error {
+	if version == AnyVersion {
+		return nil
+	}
+
+	current := 0
+	if uid, ok := s.externalToInternalId[docid]; ok {
+		current = s.documents[uid].Version
+	}
+
+	if current != version {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (s *SearchEngine) addToInverseIndex(doc Document, isNew bool) {
 	// TODO this should be more efficient. Here we remove all tokens just
 	// to re add them in the next step. We should calculate all the
 	// added/removed ones and only update those.
@@ -322,74 +662,162 @@ func (s *SearchEngine) addToInverseIndex(doc Document, isNew bool) {
 	}
 
 	// combine the tokens from all the fields together
-	for _, f := range doc.Fields {
-		for t, positions := range f.Tokens {
-			posList, ok := tokens[t]
-			if !ok {
-				tokens[t] = positions
-			} else {
-				tokens[t] = append(posList, positions...)
-			}
-		}
-	}
+	tokens := documentTokens(doc)
 
 	for t, positions := range tokens {
 		s.index.Add(t, doc.Uid, positions)
 	}
 }
 
-func (s *SearchEngine) addToKgramIndex(doc Document) {
-	tokenizer := NewSimpleTokenizer()
-	words := []string{}
+// updateInverseIndex moves the index from `prev` to `doc`, only touching the
+// rows for tokens in `changed`. Tokens that are only in unchanged fields keep
+// their existing postings.
+func (s *SearchEngine) updateInverseIndex(doc Document, prev Document, changed map[Token]bool) {
+	tokens := documentTokens(doc)
+	prevTokens := documentTokens(prev)
+
+	list := make([]Token, 0, len(tokens))
+	for t := range tokens {
+		list = append(list, t)
+	}
+	prevSet := make(map[Token]bool, len(prevTokens))
+	for t := range prevTokens {
+		prevSet[t] = true
+	}
+
+	added, removed := sortNewAndOldTokens(list, prevSet)
+
+	for _, t := range removed {
+		s.index.Remove(t, doc.Uid)
+	}
+
+	for _, t := range added {
+		s.index.Add(t, doc.Uid, tokens[t])
+		delete(changed, t)
+	}
+
+	// tokens in both versions, but their positions may have moved
+	for t := range changed {
+		positions, ok := tokens[t]
+		if !ok || !prevSet[t] {
+			continue
+		}
+		s.index.Remove(t, doc.Uid)
+		s.index.Add(t, doc.Uid, positions)
+	}
+}
+
+// prune removes index rows and k-gram terms for `tokens` that are no longer
+// in any document
+func (s *SearchEngine) prune(tokens map[Token]bool) {
+	for t := range tokens {
+		if s.index.Prune(t) {
+			s.kIndex.Remove(string(t))
+		}
+	}
+}
+
+// Compact removes every index row and k-gram term that no document contains.
+// Writes already prune what they make empty, so this is only needed for
+// indexes saved by older versions.
+func (s *SearchEngine) Compact() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for t := range s.index.table {
+		s.index.Prune(t)
+	}
+	for token := range s.kIndex.ids {
+		if s.index.DocFrequency(Token(token)) == 0 {
+			s.kIndex.Remove(token)
+		}
+	}
+	s.kIndex.compact()
+
+	return s.writeIndexToDisk()
+}
 
-	// combine all the words into one big list
+func tokenSet(doc Document) map[Token]bool {
+	tokens := map[Token]bool{}
 	for _, f := range doc.Fields {
-		words = append(words, tokenizer.CleanAndSplit(f.Value)...)
+		for t := range f.Tokens {
+			tokens[t] = true
+		}
 	}
+	return tokens
+}
 
+// documentTokens combines the tokens from all the fields of `doc` together
+func documentTokens(doc Document) map[Token][]int {
+	tokens := map[Token][]int{}
+	for _, f := range doc.Fields {
+		for t, positions := range f.Tokens {
+			tokens[t] = append(tokens[t], positions...)
+		}
+	}
+
+	// positions must be lowest to highest, fields are not visited in order
+	for _, positions := range tokens {
+		sort.Ints(positions)
+	}
+	return tokens
+}
+
+func (s *SearchEngine) addToKgramIndex(fields map[string]*Field) {
 	// add each word to the kgram index, passing in the tokenized value of each.
 	// We index under the original word and the stemmed word, but we always reference
 	// back to the tokenized value
-	for _, w := range words {
-		if tokenizer.IsStopWord(w) {
+	for name, f := range fields {
+		// n-grams are already substrings, they aren't expanded
+		a := s.analyzer(name)
+		if a.grams() {
 			continue
 		}
 
-		t := tokenizer.Stem(w)
-		s.kIndex.Add(t, t)
-		s.kIndex.Add(w, t)
+		for _, t := range a.Analyze(f.Value) {
+			if t.Stop {
+				continue
+			}
+
+			s.kIndex.Add(t.Value, t.Value)
+			s.kIndex.Add(t.Word, t.Value)
+		}
 	}
 }
 
 // returns a list of docids
-func (s *SearchEngine) _all(tokens []Token, partialMatches bool) []*hit {
+func (s *SearchEngine) _all(terms []queryTerm, field string) []*hit {
 	lookup := map[int]*hit{}
 	hits := []*hit{}
-	termBonus := 100
-
-	for _, t := range tokens {
-		r := s.index.Get(t)
-
-		// If no results found on the exact term, and partial matching is enabled
-		// perform the partial matching
-		// TODO we should include partial matches here even if len(r) == 0, but weight them differently
-		if partialMatches && len(r) == 0 {
-			partialTokens := s.kIndex.Get(t)
-			for _, t := range partialTokens {
-				r = append(r, s.index.Get(t)...)
+	termBonus := 100.0
+
+	for _, qt := range terms {
+		// a doc scores the best of the terms alternatives
+		best := map[int]float64{}
+		docs := []int{}
+
+		for _, a := range qt.alternatives {
+			for _, m := range s.matchAlternative(a, field, qt.partial) {
+				score, ok := best[m.doc]
+				if !ok {
+					docs = append(docs, m.doc)
+				}
+				if v := m.score * a.weight; !ok || v > score {
+					best[m.doc] = v
+				}
 			}
 		}
 
-		for _, doc := range r {
-			h, ok := lookup[doc.Doc]
+		for _, docid := range docs {
+			h, ok := lookup[docid]
 			if ok {
 				// every additional term match gets a bonus. This means docs that match more
 				// of the requested terms sort higher.
-				h.freq += doc.Frequency + termBonus
+				h.score += best[docid] + termBonus
 			} else {
-				h = &hit{doc: doc.Doc, freq: doc.Frequency}
+				h = &hit{doc: docid, score: best[docid]}
 				hits = append(hits, h)
-				lookup[doc.Doc] = h
+				lookup[docid] = h
 			}
 		}
 	}
@@ -398,38 +826,81 @@ func (s *SearchEngine) _all(tokens []Token, partialMatches bool) []*hit {
 	return hits
 }
 
-func (s *SearchEngine) writeIndexToDisk() {
+func (s *SearchEngine) writeDocumentToDisk(doc Document) error {
+	path := fmt.Sprintf("%v/%v", s.savePath, doc.Uid)
+
+	docJson, err := json.Marshal(doc)
+	if err != nil {
+		return &PersistenceError{Op: "encode document", Path: path, Err: err}
+	}
+
+	err = ioutil.WriteFile(path, docJson, 0770)
+	if err != nil {
+		return &PersistenceError{Op: "write document", Path: path, Err: err}
+	}
+	return nil
+}
+
+func (s *SearchEngine) removeDocumentFromDisk(uid int) error {
+	path := fmt.Sprintf("%v/%v", s.savePath, uid)
+
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return &PersistenceError{Op: "remove document", Path: path, Err: err}
+	}
+	return nil
+}
+
+func (s *SearchEngine) writeIndexToDisk() error {
+	if !s.persistent {
+		return nil
+	}
+
+	path := fmt.Sprintf("%v/%v", s.savePath, indexFileName)
+
 	// wrap fields we want exported in an exportable struct
 	json, err := json.Marshal(engineJsonExport{
 		ExternalToInternalId: s.externalToInternalId,
 		Index:                s.index.table,
-		KIndex:               s.kIndex.table,
+		KTerms:               s.kIndex.export(),
 		NextIndex:            s.index.nextIndex,
 	})
 
 	if err != nil {
-		panic(fmt.Sprintf("Failed to save search engine disk, err: %v", err.Error()))
+		return &PersistenceError{Op: "encode index", Path: path, Err: err}
 	}
 
-	ioutil.WriteFile(fmt.Sprintf("%v/%v", s.savePath, indexFileName), json, 0770)
-
 	// TODO rather than writing the whole thing everytime, we should use some type of
 	// update system
+	err = ioutil.WriteFile(path, json, 0770)
+	if err != nil {
+		return &PersistenceError{Op: "write index", Path: path, Err: err}
+	}
+	return nil
 }
 
-func (s *SearchEngine) readIndexFromDisk() {
-	bytes, err := ioutil.ReadFile(fmt.Sprintf("%v/%v", s.savePath, indexFileName))
+func (s *SearchEngine) readIndexFromDisk() error {
+	path := fmt.Sprintf("%v/%v", s.savePath, indexFileName)
+
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		// nothing has been saved yet
+		return nil
+	}
 	if err != nil {
-		fmt.Println(err.Error())
-		return
+		return &PersistenceError{Op: "read index", Path: path, Err: err}
+	}
+
+	// the index is written on every change
+	if info, err := os.Stat(path); err == nil {
+		s.updated = info.ModTime()
 	}
 
 	var savedIndex engineJsonExport
 
 	err = json.Unmarshal(bytes, &savedIndex)
 	if err != nil {
-		fmt.Println(err.Error())
-		return
+		return &PersistenceError{Op: "decode index", Path: path, Err: err}
 	}
 
 	// load docs
@@ -438,24 +909,30 @@ func (s *SearchEngine) readIndexFromDisk() {
 	// have better in-memory indexing
 	files, err := ioutil.ReadDir(s.savePath)
 	if err != nil {
-		fmt.Println(err.Error())
-		return
+		return &PersistenceError{Op: "read documents", Path: s.savePath, Err: err}
 	}
 
 	for _, f := range files {
-		if f.Name() == indexFileName {
+		// skip the index, settings, and any other non-document files
+		if strings.HasPrefix(f.Name(), "_") || f.IsDir() {
 			continue
 		}
 
-		bytes, err := ioutil.ReadFile(fmt.Sprintf("%v/%v", s.savePath, f.Name()))
+		path := fmt.Sprintf("%v/%v", s.savePath, f.Name())
+		bytes, err := ioutil.ReadFile(path)
 		if err != nil {
-			continue
+			return &PersistenceError{Op: "read document", Path: path, Err: err}
 		}
 
 		var d Document
 		err = json.Unmarshal(bytes, &d)
 		if err != nil {
-			continue
+			return &PersistenceError{Op: "decode document", Path: path, Err: err}
+		}
+
+		// documents saved before versioning was added
+		if d.Version == 0 {
+			d.Version = 1
 		}
 
 		s.documents[d.Uid] = d
@@ -465,15 +942,30 @@ func (s *SearchEngine) readIndexFromDisk() {
 	s.externalToInternalId = savedIndex.ExternalToInternalId
 	s.index.nextIndex = savedIndex.NextIndex
 	s.index.table = savedIndex.Index
-	s.kIndex.table = savedIndex.KIndex
+	if savedIndex.KTerms != nil && s.SupportWildCardQuries {
+		s.kIndex.load(savedIndex.KTerms)
+	} else if savedIndex.KIndex != nil && s.SupportWildCardQuries {
+		// older indexes don't say which word a k-gram came from, so they are
+		// rebuilt from the documents
+		s.kIndex = NewKGramIndexTableSize(s.kIndex.k)
+		for _, d := range s.documents {
+			s.addToKgramIndex(d.Fields)
+		}
+	}
+	return nil
 }
 
 func (s *hitSorter) Len() int {
 	return len(s.hits)
 }
 
+// hits are sorted by score, ties in the order the documents were added so
+// pages don't overlap
 func (s *hitSorter) Less(a int, b int) bool {
-	return s.hits[a].freq > s.hits[b].freq
+	if s.hits[a].score != s.hits[b].score {
+		return s.hits[a].score > s.hits[b].score
+	}
+	return s.hits[a].doc < s.hits[b].doc
 }
 
 func (s *hitSorter) Swap(a int, b int) {
@@ -1,8 +1,11 @@
 package search
 
 import (
-	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
+	"sync"
 )
 
 // SearchServer is an interface for creating and accessing multiple named search engines
@@ -10,15 +13,24 @@ type SearchServer struct {
 	savePath      string
 	persistent    bool
 	searchEngines map[string]*SearchEngine
+	// alias to collection name, see SetAlias
+	aliases map[string]string
+	lock    sync.RWMutex
 }
 
 func NewSearchServer() *SearchServer {
 	s := &SearchServer{}
 	s.searchEngines = map[string]*SearchEngine{}
+	s.aliases = map[string]string{}
 	return s
 }
 
-func NewPersistentSearchServer(savepath string) *SearchServer {
+// NewPersistentSearchServer creates a server that saves each collection in a
+// directory of the same name under `savepath`. The collections and aliases
+// saved by a previous server are loaded. A PersistenceError is returned if they
+// could not be, or an error wrapping ErrCollectionNotFound if aliases were
+// dropped because their collection is missing. The server can be used either way.
+func NewPersistentSearchServer(savepath string) (*SearchServer, error) {
 	s := NewSearchServer()
 	s.persistent = true
 	s.savePath = savepath
@@ -27,62 +39,339 @@ func NewPersistentSearchServer(savepath string) *SearchServer {
 		s.savePath = defaultSavePath
 	}
 
-	return s
+	// collections first, so the aliases have something to point to
+	if err := s.readCollectionsFromDisk(); err != nil {
+		return s, err
+	}
+	if err := s.readAliasesFromDisk(); err != nil {
+		return s, err
+	}
+	return s, s.dropMissingAliases()
+}
+
+// readCollectionsFromDisk loads every collection saved under the save path.
+// Collections that can't be loaded are skipped, and the first error returned.
+func (s *SearchServer) readCollectionsFromDisk() error {
+	list, err := ioutil.ReadDir(s.savePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return &PersistenceError{Op: "read collections", Path: s.savePath, Err: err}
+	}
+
+	var first error
+	for _, info := range list {
+		if !info.IsDir() || !isValidName(info.Name()) {
+			continue
+		}
+
+		e, err := NewPersistentSearchEngine(s.collectionPath(info.Name()))
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		s.searchEngines[info.Name()] = e
+	}
+	return first
+}
+
+// Create adds a new, empty, collection. Returns ErrInvalidName if the name is not
+// alpha-numeric, ErrCollectionExists if its in use, or a PersistenceError if previously
+// saved data for the collection could not be loaded.
+func (s *SearchServer) Create(name string) error {
+	return s.create(name, nil)
+}
+
+// CreateWithSettings adds a new, empty, collection using `settings`. Returns the
+// same errors as Create, or one wrapping ErrUnknownAnalyzer if the settings
+// name an analyzer that does not exist.
+func (s *SearchServer) CreateWithSettings(name string, settings Settings) error {
+	return s.create(name, &settings)
 }
 
-func (s *SearchServer) Create(name string) bool {
-	// TODO enforce alpha-numeric, return error if not
+func (s *SearchServer) create(name string, settings *Settings) error {
+	if !isValidName(name) {
+		return ErrInvalidName
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
 
-	// don't replace an existing engine
+	// don't replace an existing engine. Aliases share the same names
 	if _, ok := s.searchEngines[name]; ok {
-		return false
+		return ErrCollectionExists
+	}
+	if _, ok := s.aliases[name]; ok {
+		return ErrCollectionExists
+	}
+
+	// validate the settings before anything is written
+	if settings != nil {
+		if err := NewSearchEngine().SetSettings(*settings); err != nil {
+			return err
+		}
 	}
 
+	var e *SearchEngine
 	if s.persistent {
-		savePath := fmt.Sprintf(defaultSavePath, name)
-		s.searchEngines[name] = NewPersistentSearchEngine(savePath)
+		var err error
+		e, err = NewPersistentSearchEngine(s.collectionPath(name))
+		if err != nil {
+			return err
+		}
 	} else {
-		s.searchEngines[name] = NewSearchEngine()
+		e = NewSearchEngine()
 	}
 
-	return true
+	if settings != nil {
+		if err := e.SetSettings(*settings); err != nil {
+			return err
+		}
+	}
+
+	s.searchEngines[name] = e
+	return nil
 }
 
-func (s *SearchServer) Destroy(name string) {
+// Destroy removes a collection and its saved data. Aliases are not resolved,
+// and ErrCollectionHasAliases is returned if an alias points to the collection.
+func (s *SearchServer) Destroy(name string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.searchEngines[name]; !ok {
+		return ErrCollectionNotFound
+	}
+	for _, target := range s.aliases {
+		if target == name {
+			return ErrCollectionHasAliases
+		}
+	}
+
+	delete(s.searchEngines, name)
+
 	if s.persistent {
 		// destory persistent data
-		savepath := fmt.Sprintf(defaultSavePath, name)
-		os.RemoveAll(savepath)
+		savepath := s.collectionPath(name)
+		if err := os.RemoveAll(savepath); err != nil {
+			return &PersistenceError{Op: "remove collection", Path: savepath, Err: err}
+		}
 	}
-	delete(s.searchEngines, name)
+	return nil
 }
 
+// Exists returns true if the collection, or the collection an alias points to, exists
 func (s *SearchServer) Exists(name string) bool {
-	_, ok := s.searchEngines[name]
+	_, ok := s.engine(name)
 	return ok
 }
 
-func (s *SearchServer) Query(engine string, query Query) SearchResult {
-	e, ok := s.searchEngines[engine]
+// List returns the names of every collection, sorted. Aliases are not included.
+func (s *SearchServer) List() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	names := make([]string, 0, len(s.searchEngines))
+	for name := range s.searchEngines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Settings returns the settings of a collection
+func (s *SearchServer) Settings(engine string) (Settings, error) {
+	e, ok := s.engine(engine)
+	if !ok {
+		return Settings{}, ErrCollectionNotFound
+	}
+	return e.Settings(), nil
+}
+
+// SetSettings replaces all the settings of a collection, see
+// SearchEngine.SetSettings. Use UpdateSettings to change only some.
+func (s *SearchServer) SetSettings(engine string, settings Settings) error {
+	e, ok := s.engine(engine)
+	if !ok {
+		return ErrCollectionNotFound
+	}
+	return e.SetSettings(settings)
+}
+
+// UpdateSettings changes only the settings in the JSON object `data`, see
+// SearchEngine.UpdateSettings
+func (s *SearchServer) UpdateSettings(engine string, data []byte) (Settings, error) {
+	e, ok := s.engine(engine)
+	if !ok {
+		return Settings{}, ErrCollectionNotFound
+	}
+	return e.UpdateSettings(data)
+}
+
+func (s *SearchServer) Query(engine string, query Query) (SearchResult, error) {
+	e, ok := s.engine(engine)
+	if !ok {
+		return newSearchResult(), ErrCollectionNotFound
+	}
+	return e.Query(query), nil
+}
+
+func (s *SearchServer) Index(engine string, doc Document) error {
+	_, err := s.IndexIfVersion(engine, doc, AnyVersion)
+	return err
+}
+
+// IndexIfVersion indexes the document if its current version matches, see SearchEngine.IndexIfVersion
+func (s *SearchServer) IndexIfVersion(engine string, doc Document, version int) (WriteResult, error) {
+	e, ok := s.engine(engine)
 	if !ok {
-		return newSearchResult()
+		return WriteResult{}, ErrCollectionNotFound
 	}
-	return e.Query(query)
+	return e.IndexIfVersion(doc, version)
 }
 
-func (s *SearchServer) Index(engine string, doc Document) {
-	e, ok := s.searchEngines[engine]
+// Get returns a stored document
+func (s *SearchServer) Get(engine string, docid string) (Document, error) {
+	e, ok := s.engine(engine)
 	if !ok {
-		return
+		return Document{}, ErrCollectionNotFound
 	}
-	e.Index(doc)
+
+	doc, ok := e.Get(docid)
+	if !ok {
+		return doc, ErrDocumentNotFound
+	}
+	return doc, nil
+}
+
+// Update merges the given fields into an existing document, see SearchEngine.Update
+func (s *SearchServer) Update(engine string, docid string, fields map[string]*Field) error {
+	_, err := s.UpdateIfVersion(engine, docid, fields, AnyVersion)
+	return err
+}
+
+// UpdateIfVersion merges the given fields into an existing document if its
+// current version matches, see SearchEngine.UpdateIfVersion
+func (s *SearchServer) UpdateIfVersion(engine string, docid string, fields map[string]*Field, version int) (WriteResult, error) {
+	e, ok := s.engine(engine)
+	if !ok {
+		return WriteResult{}, ErrCollectionNotFound
+	}
+	return e.UpdateIfVersion(docid, fields, version)
 }
 
 // Remove purges the given document from the index
-func (s *SearchServer) Remove(engine string, docid string) {
-	e, ok := s.searchEngines[engine]
+func (s *SearchServer) Remove(engine string, docid string) error {
+	return s.RemoveIfVersion(engine, docid, AnyVersion)
+}
+
+// RemoveIfVersion purges the given document if its current version matches
+func (s *SearchServer) RemoveIfVersion(engine string, docid string, version int) error {
+	e, ok := s.engine(engine)
+	if !ok {
+		return ErrCollectionNotFound
+	}
+	return e.RemoveIfVersion(docid, version)
+}
+
+// Compact removes unused terms from a collections index, see SearchEngine.Compact
+func (s *SearchServer) Compact(engine string) error {
+	e, ok := s.engine(engine)
+	if !ok {
+		return ErrCollectionNotFound
+	}
+	return e.Compact()
+}
+
+// Rebuild re-tokenizes a collection in the background, see SearchEngine.StartRebuild
+func (s *SearchServer) Rebuild(engine string) error {
+	e, ok := s.engine(engine)
+	if !ok {
+		return ErrCollectionNotFound
+	}
+	return e.StartRebuild()
+}
+
+// Synonyms returns the synonym rules of a collection
+func (s *SearchServer) Synonyms(engine string) ([]SynonymRule, error) {
+	e, ok := s.engine(engine)
+	if !ok {
+		return nil, ErrCollectionNotFound
+	}
+	return e.Synonyms(), nil
+}
+
+// SetSynonyms replaces the synonym rules of a collection, see SearchEngine.SetSynonyms
+func (s *SearchServer) SetSynonyms(engine string, rules []SynonymRule) error {
+	e, ok := s.engine(engine)
 	if !ok {
-		return
+		return ErrCollectionNotFound
+	}
+	return e.SetSynonyms(rules)
+}
+
+// Stats returns the size of a collection, see SearchEngine.Stats
+func (s *SearchServer) Stats(engine string) (Stats, error) {
+	e, ok := s.engine(engine)
+	if !ok {
+		return Stats{}, ErrCollectionNotFound
+	}
+	return e.Stats(), nil
+}
+
+// Terms returns a page of a collections term dictionary, see SearchEngine.Terms
+func (s *SearchServer) Terms(engine string, query TermsQuery) (TermsResult, error) {
+	e, ok := s.engine(engine)
+	if !ok {
+		return TermsResult{Terms: []TermInfo{}}, ErrCollectionNotFound
+	}
+	return e.Terms(query), nil
+}
+
+// AllStats returns the size of every collection, by name
+func (s *SearchServer) AllStats() map[string]Stats {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	res := make(map[string]Stats, len(s.searchEngines))
+	for name, e := range s.searchEngines {
+		res[name] = e.Stats()
 	}
-	e.Remove(docid)
+	return res
+}
+
+// engine returns the named collection, or the collection `name` is an alias of
+func (s *SearchServer) engine(name string) (*SearchEngine, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if target, ok := s.aliases[name]; ok {
+		name = target
+	}
+	e, ok := s.searchEngines[name]
+	return e, ok
+}
+
+func (s *SearchServer) collectionPath(name string) string {
+	return filepath.Join(s.savePath, name)
+}
+
+// collection names are used as directory names when persisting, so they are
+// limited to a safe set of characters
+func isValidName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
 }
@@ -1,6 +1,9 @@
 package search
 
 import (
+	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
 )
 
@@ -180,7 +183,10 @@ func TestPuncuation(t *testing.T) {
 
 func TestPersistenace(t *testing.T) {
 	// create a search engine and index a document
-	s := NewPersistentSearchEngine(testDataDir)
+	s, err := NewPersistentSearchEngine(testDataDir)
+	if err != nil {
+		t.Fatalf("Failed to create persistent engine: %v", err)
+	}
 	s.Index(Document{Id: "1", Fields: map[string]*Field{
 		"title": &Field{Value: "Dogs? bears' Cat's turbo-snail"},
 		"body":  &Field{Value: "Planes, trains, automobiles!, O'Niel Cat"},
@@ -204,7 +210,10 @@ func TestPersistenace(t *testing.T) {
 
 	// replace the search engine with a new instance, then test for the document
 	// that was indexed on the previous engine
-	s = NewPersistentSearchEngine(testDataDir)
+	s, err = NewPersistentSearchEngine(testDataDir)
+	if err != nil {
+		t.Fatalf("Failed to restore persistent engine: %v", err)
+	}
 	if s.Query(Query{Terms: "bear"}).Hits == 0 {
 		t.Errorf("Search failed to restore index.")
 	}
@@ -306,6 +315,31 @@ func TestPartialMatchingAfterStemming(t *testing.T) {
 	}
 }
 
+func TestPartialMatchingIsExact(t *testing.T) {
+	s := NewSearchEngine()
+	s.SupportWildCardQuries = true
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"title": &Field{Value: "apple"},
+	},
+	})
+
+	// apple is a prefix of the query, not the other way around
+	if res := s.Query(Query{Terms: "applesauce", PartialMatch: true}); res.Hits != 0 {
+		t.Errorf("Search failed, expected zero results, got: %v", res.Documents)
+	}
+
+	if err := s.SetSettings(Settings{KGramSize: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if res := s.Query(Query{Terms: "ap", PartialMatch: true}); res.Hits != 1 {
+		t.Errorf("Search failed, expected 1 result, got: %v", res.Documents)
+	}
+	if err := s.SetSettings(Settings{KGramSize: -1}); !errors.Is(err, ErrInvalidSettings) {
+		t.Errorf("Expected an invalid settings error, got: %v", err)
+	}
+}
+
 func TestRelevenceSorting(t *testing.T) {
 	s := NewSearchEngine()
 	s.SupportWildCardQuries = true
@@ -330,3 +364,197 @@ func TestRelevenceSorting(t *testing.T) {
 		t.Errorf("Search failed, expected 2 hits with doc 2 first, got: %v", res)
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	s := NewSearchEngine()
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"title": &Field{Value: "dog fish cat"},
+		"body":  &Field{Value: "plane car truck"},
+		"tags":  &Field{Value: "animals"},
+	},
+	})
+
+	err := s.Update("1", map[string]*Field{
+		"title": &Field{Value: "bird cat"},
+		"tags":  nil,
+	})
+	if err != nil {
+		t.Errorf("Update failed, got: %v", err)
+	}
+
+	if s.Query(Query{Terms: "dog"}).Hits != 0 {
+		t.Errorf("Update failed, removed term still found")
+	}
+
+	if s.Query(Query{Terms: "bird"}).Hits != 1 || s.Query(Query{Terms: "cat"}).Hits != 1 {
+		t.Errorf("Update failed, updated field not found")
+	}
+
+	if s.Query(Query{Terms: "plane"}).Hits != 1 {
+		t.Errorf("Update failed, untouched field not found")
+	}
+
+	if s.Query(Query{Terms: "animals"}).Hits != 0 {
+		t.Errorf("Update failed, removed field still found")
+	}
+
+	doc := s.documents[s.externalToInternalId["1"]]
+	if _, ok := doc.Fields["tags"]; ok || len(doc.Fields) != 2 {
+		t.Errorf("Update failed, expected fields title and body, got: %v", doc.Fields)
+	}
+
+	if list := s.index.Get("cat"); len(list) != 1 || list[0].Frequency != 1 {
+		t.Errorf("Update failed, expected a single posting for cat, got: %v", list)
+	}
+
+	if s.Update("2", map[string]*Field{"title": &Field{Value: "bird"}}) != ErrDocumentNotFound {
+		t.Errorf("Update succeeded for a document that does not exist")
+	}
+}
+
+func TestUpdatePositions(t *testing.T) {
+	s := NewSearchEngine()
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"body":  &Field{Value: "plane car truck"},
+		"title": &Field{Value: "dog fish"},
+	},
+	})
+	indexed := s.documents[s.externalToInternalId["1"]]
+	first := indexed.Fields["title"].Tokens["dog"][0]
+
+	// alternating updates used to push positions further out every time
+	for i := 0; i < 10; i++ {
+		s.Update("1", map[string]*Field{"title": &Field{Value: "dog fish"}})
+		s.Update("1", map[string]*Field{"body": &Field{Value: "plane car truck"}})
+	}
+
+	doc := s.documents[s.externalToInternalId["1"]]
+	if p := doc.Fields["title"].Tokens["dog"]; len(p) != 1 || p[0] != first {
+		t.Errorf("Expected the positions to be laid out again, got: %v want: %v", p, first)
+	}
+	if p, _ := s.index.GetDoc("dog", doc.Uid); len(p.Positions) != 1 || p.Positions[0] != first {
+		t.Errorf("Expected the index positions to match, got: %v", p)
+	}
+
+	// fields after a changed field move, and their index rows with them
+	s.Update("1", map[string]*Field{"body": &Field{Value: "plane"}})
+	doc = s.documents[s.externalToInternalId["1"]]
+	pos := doc.Fields["title"].Tokens["dog"][0]
+	if p, _ := s.index.GetDoc("dog", doc.Uid); pos >= first || p.Positions[0] != pos {
+		t.Errorf("Expected the title to move, got: %v %v", pos, p)
+	}
+	if s.Query(Query{Terms: "\"dog fish\""}).Hits != 1 {
+		t.Errorf("Expected the phrase to match after the update")
+	}
+
+	// untouched fields keep their tokens, they are moved rather than analyzed again
+	s.SetSettings(Settings{Fields: map[string]FieldSettings{"title": {Analyzer: "keyword"}}})
+	s.Update("1", map[string]*Field{"body": &Field{Value: "plane car truck bus"}})
+	doc = s.documents[s.externalToInternalId["1"]]
+	if p := doc.Fields["title"].Tokens["dog"]; len(p) != 1 || p[0] != first+1 {
+		t.Errorf("Expected the title to be moved by one, got: %v want: %v", p, first+1)
+	}
+	if s.QueryField("title", "dog").Hits != 1 {
+		t.Errorf("Expected the title not to be re-tokenized")
+	}
+}
+
+func TestVersions(t *testing.T) {
+	s := NewSearchEngine()
+
+	res, err := s.IndexIfVersion(Document{Id: "1", Fields: map[string]*Field{
+		"title": &Field{Value: "dog fish cat"},
+	},
+	}, 0)
+	if err != nil || res.Version != 1 || !res.Created {
+		t.Errorf("Index failed for a new document, got: %v %v", res, err)
+	}
+
+	if d, _ := s.Get("1"); d.Version != 1 {
+		t.Errorf("Expected version 1, got: %v", d.Version)
+	}
+
+	_, err = s.IndexIfVersion(Document{Id: "1", Fields: map[string]*Field{
+		"title": &Field{Value: "dog fish"},
+	},
+	}, 0)
+	if err != ErrVersionConflict {
+		t.Errorf("Expected version conflict, got: %v", err)
+	}
+
+	res, err = s.UpdateIfVersion("1", map[string]*Field{"body": &Field{Value: "bird"}}, 1)
+	if err != nil || res.Version != 2 || res.Created {
+		t.Errorf("Update failed, got: %v %v", res, err)
+	}
+
+	_, err = s.UpdateIfVersion("1", map[string]*Field{"body": &Field{Value: "bird"}}, 1)
+	if err != ErrVersionConflict {
+		t.Errorf("Expected version conflict, got: %v", err)
+	}
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"title": &Field{Value: "dog fish cat"},
+	},
+	})
+
+	if res := s.Query(Query{Terms: "dog"}); res.Hits != 1 || res.Documents[0].Version != 3 {
+		t.Errorf("Expected version 3 in query results, got: %v", res)
+	}
+
+	if err = s.RemoveIfVersion("1", 2); err != ErrVersionConflict {
+		t.Errorf("Expected version conflict, got: %v", err)
+	}
+
+	if err = s.RemoveIfVersion("1", 3); err != nil {
+		t.Errorf("Remove failed, got: %v", err)
+	}
+
+	if _, ok := s.Get("1"); ok {
+		t.Errorf("Document found after remove")
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	s := NewSearchServer()
+
+	if err := s.Create("c1"); err != nil {
+		t.Errorf("Create failed, got: %v", err)
+	}
+
+	if err := s.Create("c1"); err != ErrCollectionExists {
+		t.Errorf("Expected ErrCollectionExists, got: %v", err)
+	}
+
+	if err := s.Create("../c2"); err != ErrInvalidName {
+		t.Errorf("Expected ErrInvalidName, got: %v", err)
+	}
+
+	if _, err := s.Query("c2", Query{Terms: "dog"}); err != ErrCollectionNotFound {
+		t.Errorf("Expected ErrCollectionNotFound, got: %v", err)
+	}
+
+	if err := s.Index("c2", Document{Id: "1"}); err != ErrCollectionNotFound {
+		t.Errorf("Expected ErrCollectionNotFound, got: %v", err)
+	}
+
+	if err := s.Remove("c1", "1"); err != ErrDocumentNotFound {
+		t.Errorf("Expected ErrDocumentNotFound, got: %v", err)
+	}
+
+	if err := s.Destroy("c2"); err != ErrCollectionNotFound {
+		t.Errorf("Expected ErrCollectionNotFound, got: %v", err)
+	}
+}
+
+func TestPersistenceError(t *testing.T) {
+	// a file where the engine expects a directory
+	path := testDataDir + "/not_a_dir"
+	os.MkdirAll(testDataDir, 0770)
+	ioutil.WriteFile(path, []byte("x"), 0770)
+	defer os.Remove(path)
+
+	_, err := NewPersistentSearchEngine(path)
+	if _, ok := err.(*PersistenceError); !ok {
+		t.Errorf("Expected a PersistenceError, got: %v", err)
+	}
+}
@@ -0,0 +1,457 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+type (
+	// Settings configure a search engine. Persistent engines save them with
+	// their data.
+	Settings struct {
+		// analyzer for fields without their own, defaults to DefaultAnalyzer
+		Analyzer string `json:"analyzer,omitempty"`
+		// per field settings, by field name
+		Fields map[string]FieldSettings `json:"fields,omitempty"`
+		// custom analyzer chains, usable by name in Analyzer and Fields
+		Analyzers map[string]AnalyzerConfig `json:"analyzers,omitempty"`
+		// synonyms applied to queries
+		Synonyms []SynonymRule `json:"synonyms,omitempty"`
+		// replaces the stop words of every text analyzer, if set. Analyzers without
+		// stop words, eg: keyword or code, are not changed
+		StopWords []string `json:"stop_words,omitempty"`
+		// turns stop word removal off, every query term is searched for
+		DisableStopWords bool `json:"disable_stop_words,omitempty"`
+		// the most tokens a partial query term matches, defaults to
+		// DefaultMaxExpansions
+		MaxExpansions int `json:"max_expansions,omitempty"`
+		// the size, in characters, of the k-grams partial matches are found
+		// with, defaults to DefaultKGramSize
+		KGramSize int `json:"kgram_size,omitempty"`
+		// turns partial matching off, the k-gram index isn't kept. Sets
+		// SearchEngine.SupportWildCardQuries
+		DisableWildCardQueries bool `json:"disable_wildcard_queries,omitempty"`
+		// results per page of queries without a page size, defaults to
+		// DefaultPageSize
+		PageSize int `json:"page_size,omitempty"`
+		// fields returned by queries that don't list any
+		ReturnFields []string `json:"return_fields,omitempty"`
+	}
+
+	FieldSettings struct {
+		Analyzer string `json:"analyzer,omitempty"`
+		// multiplies the score of matches in the field, defaults to 1
+		Boost float64 `json:"boost,omitempty"`
+		// index the title, meta description and headings of html in the field
+		// as `<field>.title`, `<field>.description` and `<field>.headings`
+		ExtractHTML bool `json:"extract_html,omitempty"`
+		// index the code blocks of markdown in the field as `<field>.code`
+		ExtractCode bool `json:"extract_code,omitempty"`
+		// index n-grams of the field as `<field>.ngram`, matches in it score
+		// lower than whole words
+		NGram *NGramSettings `json:"ngram,omitempty"`
+	}
+
+	// NGramSettings configure the n-grams of a field. Zero sizes use the
+	// defaults, eg: DefaultNGramMin.
+	NGramSettings struct {
+		Min int `json:"min,omitempty"`
+		Max int `json:"max,omitempty"`
+		// only grams at the start of words, for prefix matches
+		Edge bool `json:"edge,omitempty"`
+	}
+)
+
+const (
+	settingsFileName string = "_settings"
+
+	// name and default boost of the n-gram copy of a field, see FieldSettings.NGram
+	ngramField string  = "ngram"
+	ngramBoost float64 = 0.5
+
+	// DefaultMaxExpansions is the default Settings.MaxExpansions
+	DefaultMaxExpansions int = 50
+	// the weight of a partial match, before it is weighted by length
+	partialWeight float64 = 0.5
+)
+
+// Settings returns a copy of the engines settings
+func (s *SearchEngine) Settings() Settings {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.settings.copy()
+}
+
+// SetSettings validates and applies `settings`. An error wrapping
+// ErrUnknownAnalyzer is returned if an analyzer does not exist. Documents
+// already indexed are not re-tokenized, see Rebuild.
+func (s *SearchEngine) SetSettings(settings Settings) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.applySettings(settings.copy(), true)
+}
+
+// UpdateSettings applies the settings in the JSON object `data` on top of the
+// current ones, settings it leaves out keep their values. Keys set to null
+// are reset to their default. Errors are the same as SetSettings, or
+// ErrInvalidSettings if `data` can't be decoded. Returns the settings after
+// the update.
+func (s *SearchEngine) UpdateSettings(data []byte) (Settings, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	update := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &update); err != nil {
+		return Settings{}, fmt.Errorf("%w: %v", ErrInvalidSettings, err)
+	}
+
+	// json ignores null for numbers, strings and bools, so the keys set to null
+	// are left out of the current settings before the update is decoded on top
+	current := map[string]json.RawMessage{}
+	bytes, _ := json.Marshal(s.settings)
+	json.Unmarshal(bytes, &current)
+	for k, v := range update {
+		if string(v) == "null" {
+			delete(current, k)
+		}
+	}
+
+	var settings Settings
+	bytes, _ = json.Marshal(current)
+	json.Unmarshal(bytes, &settings)
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return Settings{}, fmt.Errorf("%w: %v", ErrInvalidSettings, err)
+	}
+
+	if err := s.applySettings(settings, true); err != nil {
+		return Settings{}, err
+	}
+	return settings.copy(), nil
+}
+
+func (s *SearchEngine) applySettings(settings Settings, save bool) error {
+	name := settings.Analyzer
+	if name == "" {
+		name = DefaultAnalyzer
+	}
+
+	def, err := settings.analyzer(name)
+	if err != nil {
+		return err
+	}
+
+	if settings.MaxExpansions < 0 {
+		return fmt.Errorf("%w: max expansions can't be negative", ErrInvalidSettings)
+	}
+
+	k := settings.KGramSize
+	if k == 0 {
+		k = DefaultKGramSize
+	}
+	if k < 1 {
+		return fmt.Errorf("%w: kgram size must be at least 1", ErrInvalidSettings)
+	}
+
+	if settings.PageSize < 0 {
+		return fmt.Errorf("%w: page size can't be negative", ErrInvalidSettings)
+	}
+
+	fields := map[string]*Analyzer{}
+	boosted := false
+	for field, fs := range settings.Fields {
+		if fs.Boost < 0 {
+			return fmt.Errorf("%w: field %v boost can't be negative", ErrInvalidSettings, field)
+		}
+		boosted = boosted || fs.Boost > 0 || fs.ExtractHTML || fs.NGram != nil
+
+		if fs.Analyzer == "" {
+			continue
+		}
+		a, err := settings.analyzer(fs.Analyzer)
+		if err != nil {
+			return err
+		}
+		fields[field] = a
+	}
+
+	// extracted code blocks default to the code analyzer
+	for field, fs := range settings.Fields {
+		name := field + "." + markdownCodeField
+		if _, ok := fields[name]; ok || !fs.ExtractCode {
+			continue
+		}
+		a, err := settings.analyzer("code")
+		if err != nil {
+			return err
+		}
+		fields[name] = a
+	}
+
+	// n-grams are made with the fields own analyzer, before stemming
+	for field, fs := range settings.Fields {
+		name := field + "." + ngramField
+		if _, ok := fields[name]; ok || fs.NGram == nil {
+			continue
+		}
+
+		base := fs.Analyzer
+		if base == "" {
+			base = settings.Analyzer
+		}
+		if base == "" {
+			base = DefaultAnalyzer
+		}
+
+		a, err := settings.ngramAnalyzer(base, *fs.NGram)
+		if err != nil {
+			return fmt.Errorf("%w: field %v", err, field)
+		}
+		fields[name] = a
+	}
+
+	settings.applyStopWords(def)
+	for _, a := range fields {
+		settings.applyStopWords(a)
+	}
+
+	for _, r := range settings.Synonyms {
+		if err := r.validate(); err != nil {
+			return err
+		}
+	}
+
+	synonyms := map[*Analyzer]synonymTable{}
+	if len(settings.Synonyms) > 0 {
+		synonyms[def] = compileSynonyms(settings.Synonyms, def)
+		for _, a := range fields {
+			synonyms[a] = compileSynonyms(settings.Synonyms, a)
+		}
+	}
+
+	if save && s.persistent {
+		if err := s.writeSettingsToDisk(settings); err != nil {
+			return err
+		}
+	}
+
+	s.settings = settings
+	s.settingsVersion++
+	s.defaultAnalyzer = def
+	s.fieldAnalyzers = fields
+	s.synonyms = synonyms
+	s.boosted = boosted
+	s.kIndex.resize(k)
+
+	if wildcard := !settings.DisableWildCardQueries; wildcard != s.SupportWildCardQuries {
+		s.SupportWildCardQuries = wildcard
+		s.kIndex = NewKGramIndexTableSize(k)
+		if wildcard {
+			for _, d := range s.documents {
+				s.addToKgramIndex(d.Fields)
+			}
+		}
+		if save {
+			return s.writeIndexToDisk()
+		}
+	}
+	return nil
+}
+
+func (settings Settings) ngramAnalyzer(base string, ng NGramSettings) (*Analyzer, error) {
+	f := ngramFilter{min: ng.Min, max: ng.Max, edge: ng.Edge}
+	if f.min == 0 {
+		f.min = DefaultNGramMin
+		if f.edge {
+			f.min = DefaultEdgeNGramMin
+		}
+	}
+	if f.max == 0 {
+		f.max = DefaultNGramMax
+		if f.edge {
+			f.max = DefaultEdgeNGramMax
+		}
+	}
+	if f.min < 1 || f.max < f.min {
+		return nil, fmt.Errorf("%w: ngram sizes must be at least 1 and min can't be more than max", ErrInvalidSettings)
+	}
+
+	a, err := settings.analyzer(base)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []TokenFilter{}
+	for _, tf := range a.Filters {
+		if _, ok := tf.(stemFilter); !ok {
+			filters = append(filters, tf)
+		}
+	}
+	a.Filters = append(filters, f)
+	return a, nil
+}
+
+// extractFields returns `fields` with the fields extracted from html and
+// markdown fields added, see FieldSettings. Extracted fields that are empty
+// are set to nil, so an update removes them.
+func (s *SearchEngine) extractFields(fields map[string]*Field) map[string]*Field {
+	var res map[string]*Field
+
+	for name, f := range fields {
+		fs := s.settings.Fields[name]
+		if !fs.ExtractHTML && !fs.ExtractCode && fs.NGram == nil {
+			continue
+		}
+
+		if res == nil {
+			res = make(map[string]*Field, len(fields)+4)
+			for k, v := range fields {
+				res[k] = v
+			}
+		}
+
+		value := ""
+		if f != nil {
+			value = f.Value
+		}
+
+		extracted := map[string]string{}
+		if fs.ExtractHTML {
+			doc := extractHtml(value)
+			extracted[htmlTitleField] = doc.Title
+			extracted[htmlDescriptionField] = doc.Description
+			extracted[htmlHeadingsField] = strings.Join(doc.Headings, "\n")
+		}
+		if fs.ExtractCode {
+			extracted[markdownCodeField] = strings.Join(extractMarkdown(value).Code, "\n\n")
+		}
+		if fs.NGram != nil {
+			extracted[ngramField] = value
+		}
+
+		for k, v := range extracted {
+			if v == "" {
+				res[name+"."+k] = nil
+			} else {
+				res[name+"."+k] = &Field{Value: v}
+			}
+		}
+	}
+
+	if res == nil {
+		return fields
+	}
+	return res
+}
+
+// fieldBoost returns the score multiplier of `field`
+func (s *SearchEngine) fieldBoost(field string) float64 {
+	if fs, ok := s.settings.Fields[field]; ok && fs.Boost > 0 {
+		return fs.Boost
+	}
+
+	// extracted fields
+	if i := strings.LastIndex(field, "."); i > 0 {
+		parent := s.settings.Fields[field[:i]]
+		switch field[i+1:] {
+		case htmlTitleField:
+			if parent.ExtractHTML {
+				return htmlTitleBoost
+			}
+		case htmlHeadingsField:
+			if parent.ExtractHTML {
+				return htmlHeadingBoost
+			}
+		case ngramField:
+			if parent.NGram != nil {
+				return ngramBoost
+			}
+		}
+	}
+	return 1
+}
+
+// analyzer returns the analyzer for `field`
+func (s *SearchEngine) analyzer(field string) *Analyzer {
+	if a, ok := s.fieldAnalyzers[field]; ok {
+		return a
+	}
+	return s.defaultAnalyzer
+}
+
+// analyzer looks up `name` in the custom analyzers, then the registered ones
+func (settings Settings) analyzer(name string) (*Analyzer, error) {
+	if config, ok := settings.Analyzers[name]; ok {
+		return config.build(name)
+	}
+	return NewAnalyzer(name)
+}
+
+func (settings Settings) copy() Settings {
+	c := settings
+	if settings.Fields != nil {
+		c.Fields = make(map[string]FieldSettings, len(settings.Fields))
+		for k, v := range settings.Fields {
+			if v.NGram != nil {
+				ng := *v.NGram
+				v.NGram = &ng
+			}
+			c.Fields[k] = v
+		}
+	}
+	if settings.Synonyms != nil {
+		c.Synonyms = append([]SynonymRule{}, settings.Synonyms...)
+	}
+	if settings.StopWords != nil {
+		c.StopWords = append([]string{}, settings.StopWords...)
+	}
+	if settings.ReturnFields != nil {
+		c.ReturnFields = append([]string{}, settings.ReturnFields...)
+	}
+	if settings.Analyzers != nil {
+		c.Analyzers = make(map[string]AnalyzerConfig, len(settings.Analyzers))
+		for k, v := range settings.Analyzers {
+			c.Analyzers[k] = v
+		}
+	}
+	return c
+}
+
+func (s *SearchEngine) writeSettingsToDisk(settings Settings) error {
+	path := fmt.Sprintf("%v/%v", s.savePath, settingsFileName)
+
+	bytes, err := json.Marshal(settings)
+	if err != nil {
+		return &PersistenceError{Op: "encode settings", Path: path, Err: err}
+	}
+
+	err = ioutil.WriteFile(path, bytes, 0770)
+	if err != nil {
+		return &PersistenceError{Op: "write settings", Path: path, Err: err}
+	}
+	return nil
+}
+
+func (s *SearchEngine) readSettingsFromDisk() error {
+	path := fmt.Sprintf("%v/%v", s.savePath, settingsFileName)
+
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return &PersistenceError{Op: "read settings", Path: path, Err: err}
+	}
+
+	var settings Settings
+	err = json.Unmarshal(bytes, &settings)
+	if err != nil {
+		return &PersistenceError{Op: "decode settings", Path: path, Err: err}
+	}
+
+	return s.applySettings(settings, false)
+}
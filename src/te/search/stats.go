@@ -0,0 +1,127 @@
+package search
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Stats describe the contents and size of a search engine, see SearchEngine.Stats
+type Stats struct {
+	Documents int `json:"documents"`
+	// unique tokens in the index
+	Terms int `json:"terms"`
+	// documents listed under all tokens, and under the most common token
+	Postings    int     `json:"postings"`
+	MaxPostings int     `json:"maxPostings"`
+	AvgPostings float64 `json:"avgPostings"`
+	// token occurrences in all documents
+	Positions int `json:"positions"`
+	// k-grams, and the tokens they point to. Zero if wild card queries are disabled
+	KGrams     int `json:"kgrams"`
+	KGramTerms int `json:"kgramTerms"`
+	// approximate bytes of memory used by documents and indexes
+	MemoryBytes int64 `json:"memoryBytes"`
+	// bytes saved on disk, zero if the engine isn't persistent
+	DiskBytes int64 `json:"diskBytes"`
+	// when a document was last indexed, updated or removed
+	LastUpdated time.Time `json:"lastUpdated"`
+	// if the index is being rebuilt, and why the last rebuild failed, see
+	// SearchEngine.StartRebuild
+	Rebuilding   bool   `json:"rebuilding"`
+	RebuildError string `json:"rebuildError,omitempty"`
+}
+
+// rough sizes of go values, used to estimate memory use
+const (
+	stringSize   int64 = 16
+	sliceSize    int64 = 24
+	intSize      int64 = 8
+	mapEntrySize int64 = 48
+)
+
+// Stats returns the size of the engine's documents and indexes
+func (s *SearchEngine) Stats() Stats {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	stats := Stats{
+		Documents:   len(s.documents),
+		Terms:       s.index.Len(),
+		KGrams:      len(s.kIndex.postings),
+		KGramTerms:  s.kIndex.Len(),
+		LastUpdated: s.updated,
+		Rebuilding:  s.rebuilding != nil,
+	}
+	if s.rebuildError != nil {
+		stats.RebuildError = s.rebuildError.Error()
+	}
+
+	for _, row := range s.index.table {
+		stats.Postings += len(row.Docs)
+		stats.Positions += row.Frequency
+		if len(row.Docs) > stats.MaxPostings {
+			stats.MaxPostings = len(row.Docs)
+		}
+	}
+	if stats.Terms > 0 {
+		stats.AvgPostings = float64(stats.Postings) / float64(stats.Terms)
+	}
+
+	stats.MemoryBytes = s.memorySize()
+	if s.persistent {
+		stats.DiskBytes = diskSize(s.savePath)
+	}
+	return stats
+}
+
+// memorySize estimates the bytes used by documents and indexes, from the
+// rough sizes above rather than by measuring the heap
+func (s *SearchEngine) memorySize() int64 {
+	var n int64
+
+	for _, d := range s.documents {
+		n += mapEntrySize + stringSize + int64(len(d.Id))
+		for name, f := range d.Fields {
+			n += mapEntrySize + stringSize + int64(len(name)) + stringSize + int64(len(f.Value))
+			for t, positions := range f.Tokens {
+				n += mapEntrySize + stringSize + int64(len(t)) + sliceSize + intSize*int64(len(positions))
+			}
+		}
+		// externalToInternalId
+		n += mapEntrySize + stringSize + int64(len(d.Id)) + intSize
+	}
+
+	for t, row := range s.index.table {
+		n += mapEntrySize + stringSize + int64(len(t)) + intSize + sliceSize
+		for _, d := range row.Docs {
+			n += 2*intSize + sliceSize + intSize*int64(len(d.Positions))
+		}
+	}
+
+	for token, id := range s.kIndex.ids {
+		n += mapEntrySize + stringSize + int64(len(token)) + intSize
+		for _, w := range s.kIndex.terms[id].words {
+			n += stringSize + int64(len(w))
+		}
+		n += stringSize + int64(len(token)) + sliceSize
+	}
+	for k, ids := range s.kIndex.postings {
+		n += mapEntrySize + stringSize + int64(len(k)) + sliceSize + intSize*int64(len(ids))
+	}
+
+	return n
+}
+
+// diskSize returns the bytes of every file under `path`. Files that can't be
+// read are skipped, the size is informational.
+func diskSize(path string) int64 {
+	var n int64
+	filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			n += info.Size()
+		}
+		return nil
+	})
+	return n
+}
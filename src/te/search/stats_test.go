@@ -0,0 +1,62 @@
+package search
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	s := NewSearchEngine()
+	if stats := s.Stats(); stats.Documents != 0 || stats.Terms != 0 || !stats.LastUpdated.IsZero() {
+		t.Errorf("Expected empty stats, got: %+v", stats)
+	}
+
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "dog cat cat"}}})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"title": &Field{Value: "dog fish"}}})
+
+	stats := s.Stats()
+	if stats.Documents != 2 || stats.Terms != 3 {
+		t.Errorf("Unexpected counts: %+v", stats)
+	}
+	if stats.Postings != 4 || stats.MaxPostings != 2 || stats.Positions != 5 {
+		t.Errorf("Unexpected postings: %+v", stats)
+	}
+	if stats.KGramTerms != 3 || stats.KGrams == 0 || stats.MemoryBytes == 0 {
+		t.Errorf("Unexpected sizes: %+v", stats)
+	}
+	if stats.DiskBytes != 0 || stats.LastUpdated.IsZero() {
+		t.Errorf("Unexpected disk stats: %+v", stats)
+	}
+
+	before := stats.LastUpdated
+	s.Remove("2")
+	if stats = s.Stats(); stats.Documents != 1 || stats.Terms != 2 || stats.LastUpdated.Before(before) {
+		t.Errorf("Unexpected stats after remove: %+v", stats)
+	}
+}
+
+func TestPersistentStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewPersistentSearchEngine(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "dog cat"}}})
+	if stats := s.Stats(); stats.DiskBytes == 0 {
+		t.Errorf("Expected the disk size, got: %+v", stats)
+	}
+
+	restored, err := NewPersistentSearchEngine(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats := restored.Stats(); stats.Documents != 1 || stats.LastUpdated.IsZero() {
+		t.Errorf("Expected the restored stats, got: %+v", stats)
+	}
+}
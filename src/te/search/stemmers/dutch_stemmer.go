@@ -0,0 +1,163 @@
+package stemmers
+
+import (
+	"strings"
+)
+
+// SnowballStemmerDutch stems Dutch words with the Snowball Dutch stemmer.
+// Eg: `lichamelijke` becomes `licham`
+// The word should be lower cased. See: snowballstem.org/algorithms/dutch
+type SnowballStemmerDutch struct{}
+
+var (
+	dutchVowel = vowelTest("aeiouyè")
+
+	dutchStep1  = []string{"heden", "en", "ene", "s", "se"}
+	dutchStep3b = []string{"end", "ing", "ig", "lijk", "baar", "bar"}
+)
+
+func (s SnowballStemmerDutch) Stem(str string) string {
+	rs := replaceRunes([]rune(str), "äëïöüáéíóú", "aeiouaeiou")
+
+	// initial y, y after a vowel, and i between vowels are treated as consonants
+	if len(rs) > 0 && rs[0] == 'y' {
+		rs[0] = 'Y'
+	}
+	for i := 1; i < len(rs); i++ {
+		if !dutchVowel(rs[i-1]) {
+			continue
+		}
+		switch {
+		case rs[i] == 'i' && i+1 < len(rs) && dutchVowel(rs[i+1]):
+			rs[i] = 'I'
+		case rs[i] == 'y':
+			rs[i] = 'Y'
+		}
+	}
+
+	r1, r2 := regions(rs, dutchVowel)
+	// R1 has at least 3 letters before it
+	if r1 < 3 {
+		r1 = 3
+		if r1 > len(rs) {
+			r1 = len(rs)
+		}
+	}
+
+	rs = s.step1(rs, r1)
+	rs, eFound := s.step2(rs, r1)
+	rs = s.step3a(rs, r1, r2)
+	rs = s.step3b(rs, r1, r2, eFound)
+	rs = s.step4(rs)
+
+	return string(replaceRunes(rs, "IY", "iy"))
+}
+
+func (s SnowballStemmerDutch) step1(rs []rune, r1 int) []rune {
+	suffix, start := longestSuffix(rs, 0, dutchStep1)
+
+	switch suffix {
+	case "heden":
+		if start >= r1 {
+			rs = replaceSuffix(rs, start, "heid")
+		}
+	case "en", "ene":
+		rs = s.enEnding(rs, start, r1)
+	case "s", "se":
+		if start >= r1 && start > 0 && !dutchVowel(rs[start-1]) && rs[start-1] != 'j' {
+			rs = rs[:start]
+		}
+	}
+	return rs
+}
+
+// step2 removes a final e, returning if it was removed
+func (s SnowballStemmerDutch) step2(rs []rune, r1 int) ([]rune, bool) {
+	start := len(rs) - 1
+	if start >= r1 && start > 0 && rs[start] == 'e' && !dutchVowel(rs[start-1]) {
+		return undouble(rs[:start]), true
+	}
+	return rs, false
+}
+
+func (s SnowballStemmerDutch) step3a(rs []rune, r1 int, r2 int) []rune {
+	start := len(rs) - 4
+	if !endsWithIn(rs, r2, "heid") || (start > 0 && rs[start-1] == 'c') {
+		return rs
+	}
+
+	rs = rs[:start]
+	if hasSuffix(rs, "en") {
+		rs = s.enEnding(rs, len(rs)-2, r1)
+	}
+	return rs
+}
+
+func (s SnowballStemmerDutch) step3b(rs []rune, r1 int, r2 int, eFound bool) []rune {
+	suffix, start := longestSuffix(rs, 0, dutchStep3b)
+	if suffix == "" || start < r2 {
+		return rs
+	}
+
+	switch suffix {
+	case "end", "ing":
+		rs = rs[:start]
+		if endsWithIn(rs, r2, "ig") && !hasSuffix(rs, "eig") {
+			rs = trimSuffix(rs, 2)
+		} else {
+			rs = undouble(rs)
+		}
+	case "ig":
+		if start == 0 || rs[start-1] != 'e' {
+			rs = rs[:start]
+		}
+	case "lijk":
+		rs, _ = s.step2(rs[:start], r1)
+	case "baar":
+		rs = rs[:start]
+	case "bar":
+		if eFound {
+			rs = rs[:start]
+		}
+	}
+	return rs
+}
+
+// step4 undoubles a vowel, eg: `maan` becomes `man`
+func (s SnowballStemmerDutch) step4(rs []rune) []rune {
+	n := len(rs)
+	if n < 4 {
+		return rs
+	}
+
+	last := rs[n-1]
+	if dutchVowel(last) || last == 'I' || dutchVowel(rs[n-4]) {
+		return rs
+	}
+
+	switch string(rs[n-3 : n-1]) {
+	case "aa", "ee", "oo", "uu":
+		return append(rs[:n-2], last)
+	}
+	return rs
+}
+
+// enEnding removes the `en` or `ene` starting at `start`, if it follows a
+// non-vowel that isn't part of `gem`
+func (s SnowballStemmerDutch) enEnding(rs []rune, start int, r1 int) []rune {
+	if start < r1 || start == 0 || dutchVowel(rs[start-1]) {
+		return rs
+	}
+	if strings.HasSuffix(string(rs[:start]), "gem") {
+		return rs
+	}
+	return undouble(rs[:start])
+}
+
+// undouble removes the last letter from a final kk, dd or tt
+func undouble(rs []rune) []rune {
+	if hasSuffix(rs, "kk") || hasSuffix(rs, "dd") || hasSuffix(rs, "tt") {
+		return trimSuffix(rs, 1)
+	}
+	return rs
+}
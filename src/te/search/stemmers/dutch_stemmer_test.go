@@ -0,0 +1,36 @@
+package stemmers
+
+import (
+	"testing"
+)
+
+func TestDutchStemmer(t *testing.T) {
+	s := SnowballStemmerDutch{}
+
+	tests := []struct {
+		word     string
+		expected string
+	}{
+		{"lichamelijk", "licham"},
+		{"lichamelijke", "licham"},
+		{"lichamelijkheden", "licham"},
+		{"opheffen", "opheff"},
+		{"opheffing", "opheff"},
+		{"boeken", "boek"},
+		{"maan", "man"},
+		{"manen", "man"},
+		{"broden", "brod"},
+		{"vrijheid", "vrijheid"},
+		{"mogelijkheid", "mogelijk"},
+		{"kinderen", "kinder"},
+		{"katten", "kat"},
+		{"ogen", "ogen"},
+	}
+
+	for _, test := range tests {
+		v := s.Stem(test.word)
+		if v != test.expected {
+			t.Errorf("Error: Stem on '%v' returned wrong value, got: %v, expected: %v\n", test.word, v, test.expected)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package stemmers
+
+import (
+	"strings"
+)
+
+// Porter2StemmerEnglish stems English words with the Porter2 (Snowball English)
+// stemmer, a revision of the original Porter stemmer that over-stems less.
+// Eg: `generously` becomes `generous`, where PorterStemmerEnglish gives `gener`
+// The word should be lower cased. See: snowballstem.org/algorithms/english
+type Porter2StemmerEnglish struct{}
+
+var (
+	englishVowel = vowelTest("aeiouy")
+
+	// words with irregular stems, checked before stemming
+	englishExceptions = map[string]string{
+		"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie", "tying": "tie",
+		"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli",
+		"singly": "singl", "sky": "sky", "news": "news", "howe": "howe", "atlas": "atlas",
+		"cosmos": "cosmos", "bias": "bias", "andes": "andes",
+	}
+
+	// words left as they are after step 1a
+	englishStep1aExceptions = map[string]bool{
+		"inning": true, "outing": true, "canning": true, "herring": true,
+		"earring": true, "proceed": true, "exceed": true, "succeed": true,
+	}
+
+	englishStep1a = []string{"sses", "ied", "ies", "s", "us", "ss"}
+	englishStep1b = []string{"eed", "eedly", "ed", "edly", "ing", "ingly"}
+	englishStep2  = map[string]string{
+		"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able", "entli": "ent",
+		"izer": "ize", "ization": "ize", "ational": "ate", "ation": "ate", "ator": "ate",
+		"alism": "al", "aliti": "al", "alli": "al", "fulness": "ful", "ousli": "ous",
+		"ousness": "ous", "iveness": "ive", "iviti": "ive", "biliti": "ble", "bli": "ble",
+		"ogi": "og", "fulli": "ful", "lessli": "less", "li": "",
+	}
+	englishStep3 = map[string]string{
+		"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic", "iciti": "ic",
+		"ical": "ic", "ful": "", "ness": "", "ative": "",
+	}
+	englishStep4 = []string{
+		"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment", "ent",
+		"ism", "ate", "iti", "ous", "ive", "ize", "ion",
+	}
+
+	englishStep2Suffixes = keys(englishStep2)
+	englishStep3Suffixes = keys(englishStep3)
+)
+
+func (s Porter2StemmerEnglish) Stem(str string) string {
+	if stem, ok := englishExceptions[str]; ok {
+		return stem
+	}
+
+	// short words are left as they are, before the initial apostrophe is removed
+	rs := []rune(str)
+	if len(rs) <= 2 {
+		return str
+	}
+	if rs[0] == '\'' {
+		rs = rs[1:]
+	}
+
+	// initial y, and y after a vowel, are treated as consonants
+	for i, r := range rs {
+		if r == 'y' && (i == 0 || englishVowel(rs[i-1])) {
+			rs[i] = 'Y'
+		}
+	}
+
+	r1, r2 := s.regions(rs)
+
+	rs = s.step0(rs)
+	rs = s.step1a(rs)
+	if englishStep1aExceptions[string(rs)] {
+		return string(replaceRunes(rs, "Y", "y"))
+	}
+
+	rs = s.step1b(rs, r1)
+	rs = s.step1c(rs)
+	rs = s.step2(rs, r1)
+	rs = s.step3(rs, r1, r2)
+	rs = s.step4(rs, r2)
+	rs = s.step5(rs, r1, r2)
+
+	return string(replaceRunes(rs, "Y", "y"))
+}
+
+// regions returns R1 and R2, R1 follows the prefixes gener, commun and arsen
+func (s Porter2StemmerEnglish) regions(rs []rune) (int, int) {
+	w := string(rs)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(w, prefix) {
+			r1 := len([]rune(prefix))
+			return r1, regionAfter(rs, r1, englishVowel)
+		}
+	}
+	return regions(rs, englishVowel)
+}
+
+// step0 removes possessives
+func (s Porter2StemmerEnglish) step0(rs []rune) []rune {
+	suffix, start := longestSuffix(rs, 0, []string{"'", "'s", "'s'"})
+	if suffix == "" {
+		return rs
+	}
+	return rs[:start]
+}
+
+// step1a removes plurals
+func (s Porter2StemmerEnglish) step1a(rs []rune) []rune {
+	suffix, start := longestSuffix(rs, 0, englishStep1a)
+
+	switch suffix {
+	case "sses":
+		return replaceSuffix(rs, start, "ss")
+	case "ied", "ies":
+		// `ties` becomes `tie`, `cries` becomes `cri`
+		if start > 1 {
+			return replaceSuffix(rs, start, "i")
+		}
+		return replaceSuffix(rs, start, "ie")
+	case "s":
+		// the s follows a vowel that isn't directly before it, `gaps` but not `gas`
+		for i := 0; i < start-1; i++ {
+			if englishVowel(rs[i]) {
+				return rs[:start]
+			}
+		}
+	}
+	return rs
+}
+
+func (s Porter2StemmerEnglish) step1b(rs []rune, r1 int) []rune {
+	suffix, start := longestSuffix(rs, 0, englishStep1b)
+
+	switch suffix {
+	case "":
+		return rs
+	case "eed", "eedly":
+		if start >= r1 {
+			return replaceSuffix(rs, start, "ee")
+		}
+		return rs
+	}
+
+	if !containsVowel(rs[:start], englishVowel) {
+		return rs
+	}
+	rs = rs[:start]
+
+	switch {
+	case hasSuffix(rs, "at"), hasSuffix(rs, "bl"), hasSuffix(rs, "iz"):
+		return append(rs, 'e')
+	case s.endsWithDouble(rs):
+		return trimSuffix(rs, 1)
+	case r1 == len(rs) && s.endsWithShortSyllable(rs):
+		// short words, eg: `hoping` becomes `hope`
+		return append(rs, 'e')
+	}
+	return rs
+}
+
+// step1c replaces a final y with i if it follows a non-vowel that isn't the first letter
+func (s Porter2StemmerEnglish) step1c(rs []rune) []rune {
+	n := len(rs)
+	if n > 2 && (rs[n-1] == 'y' || rs[n-1] == 'Y') && !englishVowel(rs[n-2]) {
+		rs[n-1] = 'i'
+	}
+	return rs
+}
+
+func (s Porter2StemmerEnglish) step2(rs []rune, r1 int) []rune {
+	suffix, start := longestSuffix(rs, 0, englishStep2Suffixes)
+	if suffix == "" || start < r1 {
+		return rs
+	}
+
+	switch suffix {
+	case "ogi":
+		if start == 0 || rs[start-1] != 'l' {
+			return rs
+		}
+	case "li":
+		if start == 0 || !strings.ContainsRune("cdeghkmnrt", rs[start-1]) {
+			return rs
+		}
+	}
+	return replaceSuffix(rs, start, englishStep2[suffix])
+}
+
+func (s Porter2StemmerEnglish) step3(rs []rune, r1 int, r2 int) []rune {
+	suffix, start := longestSuffix(rs, 0, englishStep3Suffixes)
+	if suffix == "" || start < r1 {
+		return rs
+	}
+	if suffix == "ative" && start < r2 {
+		return rs
+	}
+	return replaceSuffix(rs, start, englishStep3[suffix])
+}
+
+func (s Porter2StemmerEnglish) step4(rs []rune, r2 int) []rune {
+	suffix, start := longestSuffix(rs, 0, englishStep4)
+	if suffix == "" || start < r2 {
+		return rs
+	}
+	if suffix == "ion" && (start == 0 || (rs[start-1] != 's' && rs[start-1] != 't')) {
+		return rs
+	}
+	return rs[:start]
+}
+
+func (s Porter2StemmerEnglish) step5(rs []rune, r1 int, r2 int) []rune {
+	n := len(rs)
+	if n == 0 {
+		return rs
+	}
+
+	switch rs[n-1] {
+	case 'e':
+		if n-1 >= r2 || (n-1 >= r1 && !s.endsWithShortSyllable(rs[:n-1])) {
+			return rs[:n-1]
+		}
+	case 'l':
+		if n-1 >= r2 && n > 1 && rs[n-2] == 'l' {
+			return rs[:n-1]
+		}
+	}
+	return rs
+}
+
+// endsWithShortSyllable tests for a vowel followed by a non-vowel other than
+// w, x or Y and preceded by a non-vowel, or a vowel then non-vowel that make
+// up the whole word
+func (s Porter2StemmerEnglish) endsWithShortSyllable(rs []rune) bool {
+	n := len(rs)
+	if n == 2 {
+		return englishVowel(rs[0]) && !englishVowel(rs[1])
+	}
+	if n < 3 {
+		return false
+	}
+	last := rs[n-1]
+	return !englishVowel(rs[n-3]) && englishVowel(rs[n-2]) &&
+		!englishVowel(last) && last != 'w' && last != 'x' && last != 'Y'
+}
+
+func (s Porter2StemmerEnglish) endsWithDouble(rs []rune) bool {
+	for _, d := range []string{"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"} {
+		if hasSuffix(rs, d) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsVowel(rs []rune, vowel func(rune) bool) bool {
+	for _, r := range rs {
+		if vowel(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func keys(m map[string]string) []string {
+	list := make([]string, 0, len(m))
+	for k := range m {
+		list = append(list, k)
+	}
+	return list
+}
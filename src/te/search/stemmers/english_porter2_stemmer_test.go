@@ -0,0 +1,159 @@
+package stemmers
+
+import (
+	"bufio"
+	"os"
+	"testing"
+)
+
+// vectors from the Snowball English sample vocabulary, and examples from the algorithm description
+func TestPorter2Stemmer(t *testing.T) {
+	s := Porter2StemmerEnglish{}
+
+	tests := []struct {
+		word     string
+		expected string
+	}{
+		{"consign", "consign"},
+		{"consigned", "consign"},
+		{"consigning", "consign"},
+		{"consignment", "consign"},
+		{"consist", "consist"},
+		{"consisted", "consist"},
+		{"consistency", "consist"},
+		{"consistent", "consist"},
+		{"consistently", "consist"},
+		{"consisting", "consist"},
+		{"consists", "consist"},
+		{"consolation", "consol"},
+		{"consolations", "consol"},
+		{"consolatory", "consolatori"},
+		{"console", "consol"},
+		{"consoled", "consol"},
+		{"consoles", "consol"},
+		{"consolidate", "consolid"},
+		{"consolidated", "consolid"},
+		{"consolidating", "consolid"},
+		{"consoling", "consol"},
+		{"consolingly", "consol"},
+		{"consols", "consol"},
+		{"consonant", "conson"},
+		{"consort", "consort"},
+		{"consorted", "consort"},
+		{"consorting", "consort"},
+		{"conspicuous", "conspicu"},
+		{"conspicuously", "conspicu"},
+		{"conspiracy", "conspiraci"},
+		{"conspirator", "conspir"},
+		{"conspirators", "conspir"},
+		{"conspire", "conspir"},
+		{"conspired", "conspir"},
+		{"conspiring", "conspir"},
+		{"constable", "constabl"},
+		{"constables", "constabl"},
+		{"constance", "constanc"},
+		{"constancy", "constanc"},
+		{"constant", "constant"},
+		{"knack", "knack"},
+		{"knackeries", "knackeri"},
+		{"knacks", "knack"},
+		{"knag", "knag"},
+		{"knave", "knave"},
+		{"knaves", "knave"},
+		{"knavish", "knavish"},
+		{"kneaded", "knead"},
+		{"kneading", "knead"},
+		{"knee", "knee"},
+		{"kneel", "kneel"},
+		{"kneeled", "kneel"},
+		{"kneeling", "kneel"},
+		{"kneels", "kneel"},
+		{"knees", "knee"},
+		{"knell", "knell"},
+		{"knelt", "knelt"},
+		{"knew", "knew"},
+		{"knick", "knick"},
+		{"knif", "knif"},
+		{"knife", "knife"},
+		{"knight", "knight"},
+		{"knightly", "knight"},
+		{"knights", "knight"},
+		{"knit", "knit"},
+		{"knits", "knit"},
+		{"knitted", "knit"},
+		{"knitting", "knit"},
+		{"knives", "knive"},
+		{"knob", "knob"},
+		{"knobs", "knob"},
+		{"knock", "knock"},
+		{"knocked", "knock"},
+		{"knocker", "knocker"},
+		{"knockers", "knocker"},
+		{"knocking", "knock"},
+		{"knocks", "knock"},
+		{"knopp", "knopp"},
+		{"knot", "knot"},
+		{"knots", "knot"},
+		{"generously", "generous"},
+		{"running", "run"},
+		{"hoping", "hope"},
+		{"cries", "cri"},
+		{"ties", "tie"},
+		{"gaps", "gap"},
+		{"gas", "gas"},
+		{"skies", "sky"},
+		{"dying", "die"},
+		{"news", "news"},
+		{"succeeded", "succeed"},
+		{"cafés", "café"},
+	}
+
+	for _, test := range tests {
+		v := s.Stem(test.word)
+		if v != test.expected {
+			t.Errorf("Error: Stem on '%v' returned wrong value, got: %v, expected: %v\n", test.word, v, test.expected)
+		}
+	}
+}
+
+// the official Snowball English sample vocabulary, and the stem of each word
+// on the same line of the output
+func TestPorter2Vocabulary(t *testing.T) {
+	voc, err := os.Open("testdata/porter2_voc.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer voc.Close()
+	output, err := os.Open("testdata/porter2_output.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer output.Close()
+
+	s := Porter2StemmerEnglish{}
+	words := bufio.NewScanner(voc)
+	stems := bufio.NewScanner(output)
+	n, failed := 0, 0
+
+	for words.Scan() {
+		if !stems.Scan() {
+			t.Fatalf("Expected a stem for every word, ran out at line %v", n+1)
+		}
+		n++
+
+		word, expected := words.Text(), stems.Text()
+		if v := s.Stem(word); v != expected {
+			failed++
+			if failed <= 20 {
+				t.Errorf("Error: Stem on '%v' returned wrong value, got: %v, expected: %v\n", word, v, expected)
+			}
+		}
+	}
+
+	if failed > 0 {
+		t.Errorf("%v of %v words stemmed wrong", failed, n)
+	}
+	if n < 29000 {
+		t.Errorf("Expected the whole vocabulary, only read %v words", n)
+	}
+}
@@ -0,0 +1,354 @@
+package stemmers
+
+import (
+	"strings"
+)
+
+// SnowballStemmerFrench stems French words with the Snowball French stemmer.
+// Eg: `continuellement` becomes `continuel`
+// The word should be lower cased. See: snowballstem.org/algorithms/french
+type SnowballStemmerFrench struct{}
+
+var (
+	frenchVowel = vowelTest("aeiouyâàëéêèïîôûù")
+
+	frenchStep1 = []string{
+		"ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes", "ables", "istes",
+		"atrice", "ateur", "ation", "atrices", "ateurs", "ations",
+		"logie", "logies", "usion", "ution", "usions", "utions", "ence", "ences",
+		"ement", "ements", "ité", "ités", "if", "ive", "ifs", "ives",
+		"eaux", "aux", "euse", "euses", "issement", "issements",
+		"amment", "emment", "ment", "ments",
+	}
+	frenchStep2a = []string{
+		"îmes", "ît", "îtes", "i", "ie", "ies", "ir", "ira", "irai", "iraIent", "irais", "irait", "iras",
+		"irent", "irez", "iriez", "irions", "irons", "iront", "is", "issaIent", "issais", "issait",
+		"issant", "issante", "issantes", "issants", "isse", "issent", "isses", "issez", "issiez",
+		"issions", "issons", "it",
+	}
+	frenchStep2b = []string{
+		"ions",
+		"é", "ée", "ées", "és", "èrent", "er", "era", "erai", "eraIent", "erais", "erait", "eras",
+		"erez", "eriez", "erions", "erons", "eront", "ez", "iez",
+		"âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante", "antes", "ants", "as",
+		"asse", "assent", "asses", "assiez", "assions",
+	}
+	frenchStep4 = []string{"ion", "ier", "ière", "Ier", "Ière", "e", "ë"}
+)
+
+func (s SnowballStemmerFrench) Stem(str string) string {
+	rs := s.prelude([]rune(str))
+	rv := frenchRV(rs)
+	r1, r2 := regions(rs, frenchVowel)
+
+	var changed bool
+	rs, changed = s.step1(rs, rv, r1, r2)
+	if !changed {
+		rs, changed = s.step2a(rs, rv)
+	}
+	if !changed {
+		rs, changed = s.step2b(rs, rv, r2)
+	}
+
+	if changed {
+		// step 3
+		if n := len(rs); n > 0 {
+			switch rs[n-1] {
+			case 'Y':
+				rs[n-1] = 'i'
+			case 'ç':
+				rs[n-1] = 'c'
+			}
+		}
+	} else {
+		rs = s.step4(rs, rv, r2)
+	}
+
+	rs = s.step5(rs)
+	rs = s.step6(rs)
+
+	return string(replaceRunes(rs, "IUY", "iuy"))
+}
+
+// prelude marks vowels that are treated as consonants. u and i between
+// vowels, y next to a vowel, and u after q.
+func (s SnowballStemmerFrench) prelude(rs []rune) []rune {
+	for i, r := range rs {
+		prevVowel := i > 0 && frenchVowel(rs[i-1])
+		nextVowel := i+1 < len(rs) && frenchVowel(rs[i+1])
+
+		switch {
+		case r == 'u' && prevVowel && nextVowel:
+			rs[i] = 'U'
+		case r == 'i' && prevVowel && nextVowel:
+			rs[i] = 'I'
+		case r == 'y' && (prevVowel || nextVowel):
+			rs[i] = 'Y'
+		case r == 'u' && i > 0 && rs[i-1] == 'q':
+			rs[i] = 'U'
+		}
+	}
+	return rs
+}
+
+// frenchRV returns the start of the RV region. If the word starts with two
+// vowels, RV is the region after the third letter, otherwise it's the region
+// after the first vowel that isn't the first letter.
+func frenchRV(rs []rune) int {
+	if len(rs) >= 3 && frenchVowel(rs[0]) && frenchVowel(rs[1]) {
+		return 3
+	}
+
+	w := string(rs)
+	if strings.HasPrefix(w, "par") || strings.HasPrefix(w, "col") || strings.HasPrefix(w, "tap") {
+		return 3
+	}
+
+	for i := 1; i < len(rs); i++ {
+		if frenchVowel(rs[i]) {
+			return i + 1
+		}
+	}
+	return len(rs)
+}
+
+// step1 removes standard suffixes, returning if the verb steps should be
+// skipped. `-ment` endings are removed, but still go through the verb steps.
+func (s SnowballStemmerFrench) step1(rs []rune, rv int, r1 int, r2 int) ([]rune, bool) {
+	suffix, start := longestSuffix(rs, 0, frenchStep1)
+
+	switch suffix {
+	case "":
+		return rs, false
+
+	case "ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes", "ables", "istes":
+		if start < r2 {
+			return rs, false
+		}
+		return rs[:start], true
+
+	case "atrice", "ateur", "ation", "atrices", "ateurs", "ations":
+		if start < r2 {
+			return rs, false
+		}
+		rs = rs[:start]
+		if hasSuffix(rs, "ic") {
+			if endsWithIn(rs, r2, "ic") {
+				rs = trimSuffix(rs, 2)
+			} else {
+				rs = replaceSuffix(rs, len(rs)-2, "iqU")
+			}
+		}
+		return rs, true
+
+	case "logie", "logies":
+		if start < r2 {
+			return rs, false
+		}
+		return replaceSuffix(rs, start, "log"), true
+
+	case "usion", "ution", "usions", "utions":
+		if start < r2 {
+			return rs, false
+		}
+		return replaceSuffix(rs, start, "u"), true
+
+	case "ence", "ences":
+		if start < r2 {
+			return rs, false
+		}
+		return replaceSuffix(rs, start, "ent"), true
+
+	case "ement", "ements":
+		if start < rv {
+			return rs, false
+		}
+		rs = rs[:start]
+
+		p, pstart := longestSuffix(rs, 0, []string{"iv", "eus", "abl", "iqU", "ièr", "Ièr"})
+		switch p {
+		case "iv":
+			if pstart >= r2 {
+				rs = rs[:pstart]
+				if endsWithIn(rs, r2, "at") {
+					rs = trimSuffix(rs, 2)
+				}
+			}
+		case "eus":
+			if pstart >= r2 {
+				rs = rs[:pstart]
+			} else if pstart >= r1 {
+				rs = replaceSuffix(rs, pstart, "eux")
+			}
+		case "abl", "iqU":
+			if pstart >= r2 {
+				rs = rs[:pstart]
+			}
+		case "ièr", "Ièr":
+			if pstart >= rv {
+				rs = replaceSuffix(rs, pstart, "i")
+			}
+		}
+		return rs, true
+
+	case "ité", "ités":
+		if start < r2 {
+			return rs, false
+		}
+		rs = rs[:start]
+
+		p, pstart := longestSuffix(rs, 0, []string{"abil", "ic", "iv"})
+		switch p {
+		case "abil":
+			if pstart >= r2 {
+				rs = rs[:pstart]
+			} else {
+				rs = replaceSuffix(rs, pstart, "abl")
+			}
+		case "ic":
+			if pstart >= r2 {
+				rs = rs[:pstart]
+			} else {
+				rs = replaceSuffix(rs, pstart, "iqU")
+			}
+		case "iv":
+			if pstart >= r2 {
+				rs = rs[:pstart]
+			}
+		}
+		return rs, true
+
+	case "if", "ive", "ifs", "ives":
+		if start < r2 {
+			return rs, false
+		}
+		rs = rs[:start]
+		if endsWithIn(rs, r2, "at") {
+			rs = trimSuffix(rs, 2)
+			if hasSuffix(rs, "ic") {
+				if endsWithIn(rs, r2, "ic") {
+					rs = trimSuffix(rs, 2)
+				} else {
+					rs = replaceSuffix(rs, len(rs)-2, "iqU")
+				}
+			}
+		}
+		return rs, true
+
+	case "eaux":
+		return replaceSuffix(rs, start, "eau"), true
+
+	case "aux":
+		if start < r1 {
+			return rs, false
+		}
+		return replaceSuffix(rs, start, "al"), true
+
+	case "euse", "euses":
+		if start >= r2 {
+			return rs[:start], true
+		}
+		if start >= r1 {
+			return replaceSuffix(rs, start, "eux"), true
+		}
+		return rs, false
+
+	case "issement", "issements":
+		if start < r1 || frenchVowel(rs[start-1]) {
+			return rs, false
+		}
+		return rs[:start], true
+
+	case "amment":
+		if start >= rv {
+			rs = replaceSuffix(rs, start, "ant")
+		}
+	case "emment":
+		if start >= rv {
+			rs = replaceSuffix(rs, start, "ent")
+		}
+	case "ment", "ments":
+		if start > 0 && start-1 >= rv && frenchVowel(rs[start-1]) {
+			rs = rs[:start]
+		}
+	}
+
+	return rs, false
+}
+
+// step2a removes verb suffixes beginning with i, returning if one was removed
+func (s SnowballStemmerFrench) step2a(rs []rune, rv int) ([]rune, bool) {
+	suffix, start := longestSuffix(rs, rv, frenchStep2a)
+	// the suffix must follow a non-vowel, also in RV
+	if suffix == "" || start-1 < rv || frenchVowel(rs[start-1]) {
+		return rs, false
+	}
+	return rs[:start], true
+}
+
+// step2b removes other verb suffixes, returning if one was removed
+func (s SnowballStemmerFrench) step2b(rs []rune, rv int, r2 int) ([]rune, bool) {
+	suffix, start := longestSuffix(rs, rv, frenchStep2b)
+
+	switch suffix {
+	case "":
+		return rs, false
+	case "ions":
+		if start < r2 {
+			return rs, false
+		}
+		return rs[:start], true
+	case "âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante", "antes", "ants", "as",
+		"asse", "assent", "asses", "assiez", "assions":
+		rs = rs[:start]
+		if endsWithIn(rs, rv, "e") {
+			rs = trimSuffix(rs, 1)
+		}
+		return rs, true
+	}
+	return rs[:start], true
+}
+
+// step4 removes residual suffixes
+func (s SnowballStemmerFrench) step4(rs []rune, rv int, r2 int) []rune {
+	if n := len(rs); n > 1 && rs[n-1] == 's' && !strings.ContainsRune("aiouès", rs[n-2]) {
+		rs = rs[:n-1]
+	}
+
+	suffix, start := longestSuffix(rs, rv, frenchStep4)
+	switch suffix {
+	case "ion":
+		if start >= r2 && start-1 >= rv && (rs[start-1] == 's' || rs[start-1] == 't') {
+			rs = rs[:start]
+		}
+	case "ier", "ière", "Ier", "Ière":
+		rs = replaceSuffix(rs, start, "i")
+	case "e":
+		rs = rs[:start]
+	case "ë":
+		if start-2 >= rv && hasSuffix(rs[:start], "gu") {
+			rs = rs[:start]
+		}
+	}
+	return rs
+}
+
+// step5 undoubles enn, onn, ett, ell and eill endings
+func (s SnowballStemmerFrench) step5(rs []rune) []rune {
+	if p, _ := longestSuffix(rs, 0, []string{"enn", "onn", "ett", "ell", "eill"}); p != "" {
+		return trimSuffix(rs, 1)
+	}
+	return rs
+}
+
+// step6 removes the accent from a final é or è followed by at least one non-vowel
+func (s SnowballStemmerFrench) step6(rs []rune) []rune {
+	i := len(rs) - 1
+	for i >= 0 && !frenchVowel(rs[i]) {
+		i--
+	}
+	if i >= 0 && i < len(rs)-1 && (rs[i] == 'é' || rs[i] == 'è') {
+		rs[i] = 'e'
+	}
+	return rs
+}
@@ -0,0 +1,48 @@
+package stemmers
+
+import (
+	"testing"
+)
+
+func TestFrenchStemmer(t *testing.T) {
+	s := SnowballStemmerFrench{}
+
+	tests := []struct {
+		word     string
+		expected string
+	}{
+		{"continu", "continu"},
+		{"continua", "continu"},
+		{"continuait", "continu"},
+		{"continuant", "continu"},
+		{"continuation", "continu"},
+		{"continue", "continu"},
+		{"continué", "continu"},
+		{"continuel", "continuel"},
+		{"continuelle", "continuel"},
+		{"continuellement", "continuel"},
+		{"continuelles", "continuel"},
+		{"continuer", "continu"},
+		{"continuez", "continu"},
+		{"continuité", "continu"},
+		{"continûment", "continû"},
+		{"contradictoirement", "contradictoir"},
+		{"majestueusement", "majestu"},
+		{"majesté", "majest"},
+		{"chevaux", "cheval"},
+		{"yeux", "yeux"},
+		{"nationalité", "national"},
+		{"gouvernement", "gouvern"},
+		{"finissaient", "fin"},
+		{"aimerions", "aim"},
+		{"gracieux", "gracieux"},
+		{"inquiétude", "inquiétud"},
+	}
+
+	for _, test := range tests {
+		v := s.Stem(test.word)
+		if v != test.expected {
+			t.Errorf("Error: Stem on '%v' returned wrong value, got: %v, expected: %v\n", test.word, v, test.expected)
+		}
+	}
+}
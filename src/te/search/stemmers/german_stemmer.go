@@ -0,0 +1,127 @@
+package stemmers
+
+import (
+	"strings"
+)
+
+// SnowballStemmerGerman stems German words with the Snowball German stemmer.
+// Eg: `aufeinanderfolgenden` becomes `aufeinanderfolg`
+// The word should be lower cased. See: snowballstem.org/algorithms/german
+type SnowballStemmerGerman struct{}
+
+var (
+	germanVowel = vowelTest("aeiouyäöü")
+
+	germanStep1 = []string{"em", "ern", "er", "e", "en", "es", "s"}
+	germanStep2 = []string{"en", "er", "est", "st"}
+	germanStep3 = []string{"end", "ung", "ig", "ik", "isch", "lich", "heit", "keit"}
+)
+
+func (s SnowballStemmerGerman) Stem(str string) string {
+	rs := []rune(strings.Replace(str, "ß", "ss", -1))
+
+	// u and y between vowels are treated as consonants
+	for i := 1; i < len(rs)-1; i++ {
+		if germanVowel(rs[i-1]) && germanVowel(rs[i+1]) {
+			switch rs[i] {
+			case 'u':
+				rs[i] = 'U'
+			case 'y':
+				rs[i] = 'Y'
+			}
+		}
+	}
+
+	r1, r2 := regions(rs, germanVowel)
+	// R1 has at least 3 letters before it
+	if r1 < 3 {
+		r1 = 3
+		if r1 > len(rs) {
+			r1 = len(rs)
+		}
+	}
+
+	rs = s.step1(rs, r1)
+	rs = s.step2(rs, r1)
+	rs = s.step3(rs, r1, r2)
+
+	return string(replaceRunes(rs, "UYäöü", "uyaou"))
+}
+
+func (s SnowballStemmerGerman) step1(rs []rune, r1 int) []rune {
+	suffix, start := longestSuffix(rs, 0, germanStep1)
+	if suffix == "" || start < r1 {
+		return rs
+	}
+
+	switch suffix {
+	case "em", "ern", "er":
+		return rs[:start]
+	case "e", "en", "es":
+		rs = rs[:start]
+		if hasSuffix(rs, "niss") {
+			rs = trimSuffix(rs, 1)
+		}
+	case "s":
+		if start > 0 && germanSEnding(rs[start-1]) {
+			rs = rs[:start]
+		}
+	}
+	return rs
+}
+
+func (s SnowballStemmerGerman) step2(rs []rune, r1 int) []rune {
+	suffix, start := longestSuffix(rs, 0, germanStep2)
+	if suffix == "" || start < r1 {
+		return rs
+	}
+
+	if suffix == "st" {
+		// st is removed after a valid ending, itself preceded by at least 3 letters
+		if start-1 >= 3 && germanSTEnding(rs[start-1]) {
+			rs = rs[:start]
+		}
+		return rs
+	}
+	return rs[:start]
+}
+
+func (s SnowballStemmerGerman) step3(rs []rune, r1 int, r2 int) []rune {
+	suffix, start := longestSuffix(rs, 0, germanStep3)
+	if suffix == "" || start < r2 {
+		return rs
+	}
+
+	switch suffix {
+	case "end", "ung":
+		rs = rs[:start]
+		if endsWithIn(rs, r2, "ig") && !hasSuffix(rs, "eig") {
+			rs = trimSuffix(rs, 2)
+		}
+	case "ig", "ik", "isch":
+		if start == 0 || rs[start-1] != 'e' {
+			rs = rs[:start]
+		}
+	case "lich", "heit":
+		rs = rs[:start]
+		if endsWithIn(rs, r1, "er") || endsWithIn(rs, r1, "en") {
+			rs = trimSuffix(rs, 2)
+		}
+	case "keit":
+		rs = rs[:start]
+		if endsWithIn(rs, r2, "lich") {
+			rs = trimSuffix(rs, 4)
+		} else if endsWithIn(rs, r2, "ig") {
+			rs = trimSuffix(rs, 2)
+		}
+	}
+	return rs
+}
+
+func germanSEnding(r rune) bool {
+	return strings.ContainsRune("bdfghklmnrt", r)
+}
+
+func germanSTEnding(r rune) bool {
+	return strings.ContainsRune("bdfghklmnt", r)
+}
@@ -0,0 +1,40 @@
+package stemmers
+
+import (
+	"testing"
+)
+
+func TestGermanStemmer(t *testing.T) {
+	s := SnowballStemmerGerman{}
+
+	tests := []struct {
+		word     string
+		expected string
+	}{
+		{"aufeinanderfolge", "aufeinanderfolg"},
+		{"aufeinanderfolgen", "aufeinanderfolg"},
+		{"aufeinanderfolgenden", "aufeinanderfolg"},
+		{"aufeinanderfolgenderweise", "aufeinanderfolgenderweis"},
+		{"aufeinanderhäufen", "aufeinanderhauf"},
+		{"kategorie", "kategori"},
+		{"kategorien", "kategori"},
+		{"kategorisch", "kategor"},
+		{"kategorische", "kategor"},
+		{"häuser", "haus"},
+		{"häuslich", "hauslich"},
+		{"bedeutung", "bedeut"},
+		{"freundlichkeit", "freundlich"},
+		{"straße", "strass"},
+		{"schönheit", "schonheit"},
+		{"kinder", "kind"},
+		{"geschichtlich", "geschicht"},
+		{"verständnisse", "verstandnis"},
+	}
+
+	for _, test := range tests {
+		v := s.Stem(test.word)
+		if v != test.expected {
+			t.Errorf("Error: Stem on '%v' returned wrong value, got: %v, expected: %v\n", test.word, v, test.expected)
+		}
+	}
+}
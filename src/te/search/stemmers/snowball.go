@@ -0,0 +1,99 @@
+package stemmers
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Helpers shared by the Snowball stemmers. Words are stemmed as rune slices
+// so accented characters are handled correctly. See: snowballstem.org
+
+// vowelTest returns a func testing if a rune is one of `vowels`
+func vowelTest(vowels string) func(rune) bool {
+	return func(r rune) bool {
+		return strings.ContainsRune(vowels, r)
+	}
+}
+
+// regions returns the start of the standard R1 and R2 regions. R1 is the
+// region after the first non-vowel following a vowel, R2 is the same
+// region within R1. Either is the end of the word if not found.
+func regions(rs []rune, vowel func(rune) bool) (int, int) {
+	r1 := regionAfter(rs, 0, vowel)
+	return r1, regionAfter(rs, r1, vowel)
+}
+
+// regionAfter returns the position after the first non-vowel following a
+// vowel, searching from `start`
+func regionAfter(rs []rune, start int, vowel func(rune) bool) int {
+	for i := start + 1; i < len(rs); i++ {
+		if vowel(rs[i-1]) && !vowel(rs[i]) {
+			return i + 1
+		}
+	}
+	return len(rs)
+}
+
+// longestSuffix returns the longest of `suffixes` that `rs` ends with and that
+// starts at or after `limit`, and the position it starts at. Returns “ and
+// -1 if none match.
+func longestSuffix(rs []rune, limit int, suffixes []string) (string, int) {
+	best := ""
+	start := -1
+
+	for _, s := range suffixes {
+		n := utf8.RuneCountInString(s)
+		if n <= utf8.RuneCountInString(best) || len(rs)-n < limit {
+			continue
+		}
+		if hasSuffix(rs, s) {
+			best = s
+			start = len(rs) - n
+		}
+	}
+	return best, start
+}
+
+func hasSuffix(rs []rune, s string) bool {
+	i := len(rs)
+	for len(s) > 0 {
+		r, size := utf8.DecodeLastRuneInString(s)
+		i--
+		if i < 0 || rs[i] != r {
+			return false
+		}
+		s = s[:len(s)-size]
+	}
+	return true
+}
+
+// endsWithIn tests if the word ends with `s`, and `s` starts at or after `limit`
+func endsWithIn(rs []rune, limit int, s string) bool {
+	return len(rs)-utf8.RuneCountInString(s) >= limit && hasSuffix(rs, s)
+}
+
+// trimSuffix removes the last `n` runes
+func trimSuffix(rs []rune, n int) []rune {
+	return rs[:len(rs)-n]
+}
+
+// replaceSuffix replaces everything after `start` with `s`
+func replaceSuffix(rs []rune, start int, s string) []rune {
+	return append(rs[:start], []rune(s)...)
+}
+
+// replaceRunes returns `rs` with every rune in `from` replaced by the rune at
+// the same position in `to`
+func replaceRunes(rs []rune, from string, to string) []rune {
+	f := []rune(from)
+	t := []rune(to)
+	for i, r := range rs {
+		for j := range f {
+			if r == f[j] {
+				rs[i] = t[j]
+				break
+			}
+		}
+	}
+	return rs
+}
@@ -0,0 +1,214 @@
+package stemmers
+
+// SnowballStemmerSpanish stems Spanish words with the Snowball Spanish stemmer.
+// Eg: `torniquetes` becomes `torniquet`
+// The word should be lower cased. See: snowballstem.org/algorithms/spanish
+type SnowballStemmerSpanish struct{}
+
+var (
+	spanishVowel = vowelTest("aeiouáéíóúü")
+
+	spanishPronouns = []string{"me", "se", "sela", "selo", "selas", "selos", "la", "le", "lo", "las", "les", "los", "nos"}
+	spanishGerunds  = []string{"iéndo", "ándo", "ár", "ér", "ír", "ando", "iendo", "ar", "er", "ir", "yendo"}
+
+	spanishStep1 = []string{
+		"anza", "anzas", "ico", "ica", "icos", "icas", "ismo", "ismos", "able", "ables", "ible", "ibles",
+		"ista", "istas", "oso", "osa", "osos", "osas", "amiento", "amientos", "imiento", "imientos",
+		"adora", "ador", "ación", "adoras", "adores", "aciones", "ante", "antes", "ancia", "ancias",
+		"logía", "logías", "ución", "uciones", "encia", "encias", "amente", "mente",
+		"idad", "idades", "iva", "ivo", "ivas", "ivos",
+	}
+	spanishStep2a = []string{"ya", "ye", "yan", "yen", "yeron", "yendo", "yo", "yó", "yas", "yes", "yais", "yamos"}
+	spanishStep2b = []string{
+		"en", "es", "éis", "emos",
+		"arían", "arías", "arán", "arás", "aríais", "aría", "aréis", "aríamos", "aremos", "ará", "aré",
+		"erían", "erías", "erán", "erás", "eríais", "ería", "eréis", "eríamos", "eremos", "erá", "eré",
+		"irían", "irías", "irán", "irás", "iríais", "iría", "iréis", "iríamos", "iremos", "irá", "iré",
+		"aba", "ada", "ida", "ía", "ara", "iera", "ad", "ed", "id", "ase", "iese", "aste", "iste", "an",
+		"aban", "ían", "aran", "ieran", "asen", "iesen", "aron", "ieron", "ado", "ido", "ando", "iendo",
+		"ió", "ar", "er", "ir", "as", "abas", "adas", "idas", "ías", "aras", "ieras", "ases", "ieses",
+		"ís", "áis", "abais", "íais", "arais", "ierais", "aseis", "ieseis", "asteis", "isteis", "ados",
+		"idos", "amos", "ábamos", "íamos", "imos", "áramos", "iéramos", "iésemos", "ásemos",
+	}
+	spanishStep3 = []string{"os", "a", "o", "á", "í", "ó", "e", "é"}
+)
+
+func (s SnowballStemmerSpanish) Stem(str string) string {
+	rs := []rune(str)
+	rv := spanishRV(rs)
+	r1, r2 := regions(rs, spanishVowel)
+
+	rs = s.step0(rs, rv)
+
+	var removed bool
+	rs, removed = s.step1(rs, r1, r2)
+	if !removed {
+		rs, removed = s.step2a(rs, rv)
+	}
+	if !removed {
+		rs = s.step2b(rs, rv)
+	}
+	rs = s.step3(rs, rv)
+
+	return string(replaceRunes(rs, "áéíóú", "aeiou"))
+}
+
+// spanishRV returns the start of the RV region. If the second letter is a
+// consonant, RV is the region after the next vowel. If the first two letters
+// are vowels, it's the region after the next consonant. Otherwise it's the
+// region after the third letter.
+func spanishRV(rs []rune) int {
+	if len(rs) < 2 {
+		return len(rs)
+	}
+
+	if !spanishVowel(rs[1]) {
+		for i := 2; i < len(rs); i++ {
+			if spanishVowel(rs[i]) {
+				return i + 1
+			}
+		}
+		return len(rs)
+	}
+
+	if spanishVowel(rs[0]) {
+		for i := 2; i < len(rs); i++ {
+			if !spanishVowel(rs[i]) {
+				return i + 1
+			}
+		}
+		return len(rs)
+	}
+
+	if len(rs) < 3 {
+		return len(rs)
+	}
+	return 3
+}
+
+// step0 removes attached pronouns, eg: `dándole` becomes `dando`
+func (s SnowballStemmerSpanish) step0(rs []rune, rv int) []rune {
+	pronoun, start := longestSuffix(rs, 0, spanishPronouns)
+	if pronoun == "" {
+		return rs
+	}
+
+	verb := rs[:start]
+	ending, vstart := longestSuffix(verb, 0, spanishGerunds)
+	if ending == "" || vstart < rv {
+		return rs
+	}
+
+	switch ending {
+	case "iéndo", "ándo", "ár", "ér", "ír":
+		// the accent is only needed while the pronoun is attached
+		replaceRunes(verb[vstart:], "áéí", "aei")
+		return verb
+	case "yendo":
+		if vstart > 0 && verb[vstart-1] == 'u' {
+			return verb
+		}
+		return rs
+	}
+	return verb
+}
+
+// step1 removes standard suffixes, returning if one was removed
+func (s SnowballStemmerSpanish) step1(rs []rune, r1 int, r2 int) ([]rune, bool) {
+	suffix, start := longestSuffix(rs, 0, spanishStep1)
+	if suffix == "" {
+		return rs, false
+	}
+
+	if suffix == "amente" {
+		if start < r1 {
+			return rs, false
+		}
+		rs = rs[:start]
+		if endsWithIn(rs, r2, "iv") {
+			rs = trimSuffix(rs, 2)
+			if endsWithIn(rs, r2, "at") {
+				rs = trimSuffix(rs, 2)
+			}
+		} else if endsWithIn(rs, r2, "os") || endsWithIn(rs, r2, "ic") || endsWithIn(rs, r2, "ad") {
+			rs = trimSuffix(rs, 2)
+		}
+		return rs, true
+	}
+
+	if start < r2 {
+		return rs, false
+	}
+
+	switch suffix {
+	case "adora", "ador", "ación", "adoras", "adores", "aciones", "ante", "antes", "ancia", "ancias":
+		rs = rs[:start]
+		if endsWithIn(rs, r2, "ic") {
+			rs = trimSuffix(rs, 2)
+		}
+	case "logía", "logías":
+		rs = replaceSuffix(rs, start, "log")
+	case "ución", "uciones":
+		rs = replaceSuffix(rs, start, "u")
+	case "encia", "encias":
+		rs = replaceSuffix(rs, start, "ente")
+	case "mente":
+		rs = rs[:start]
+		if p, _ := longestSuffix(rs, r2, []string{"ante", "able", "ible"}); p != "" {
+			rs = trimSuffix(rs, 4)
+		}
+	case "idad", "idades":
+		rs = rs[:start]
+		if p, pstart := longestSuffix(rs, r2, []string{"abil", "ic", "iv"}); p != "" {
+			rs = rs[:pstart]
+		}
+	case "iva", "ivo", "ivas", "ivos":
+		rs = rs[:start]
+		if endsWithIn(rs, r2, "at") {
+			rs = trimSuffix(rs, 2)
+		}
+	default:
+		rs = rs[:start]
+	}
+	return rs, true
+}
+
+// step2a removes verb suffixes beginning with y, returning if one was removed
+func (s SnowballStemmerSpanish) step2a(rs []rune, rv int) ([]rune, bool) {
+	suffix, start := longestSuffix(rs, rv, spanishStep2a)
+	if suffix != "" && start > 0 && rs[start-1] == 'u' {
+		return rs[:start], true
+	}
+	return rs, false
+}
+
+// step2b removes other verb suffixes
+func (s SnowballStemmerSpanish) step2b(rs []rune, rv int) []rune {
+	suffix, start := longestSuffix(rs, rv, spanishStep2b)
+	if suffix == "" {
+		return rs
+	}
+
+	rs = rs[:start]
+	switch suffix {
+	case "en", "es", "éis", "emos":
+		if hasSuffix(rs, "gu") {
+			rs = trimSuffix(rs, 1)
+		}
+	}
+	return rs
+}
+
+// step3 removes residual suffixes
+func (s SnowballStemmerSpanish) step3(rs []rune, rv int) []rune {
+	suffix, start := longestSuffix(rs, 0, spanishStep3)
+	if suffix == "" || start < rv {
+		return rs
+	}
+
+	rs = rs[:start]
+	if (suffix == "e" || suffix == "é") && endsWithIn(rs, rv, "u") && hasSuffix(rs, "gu") {
+		rs = trimSuffix(rs, 1)
+	}
+	return rs
+}
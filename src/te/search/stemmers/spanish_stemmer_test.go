@@ -0,0 +1,37 @@
+package stemmers
+
+import (
+	"testing"
+)
+
+func TestSpanishStemmer(t *testing.T) {
+	s := SnowballStemmerSpanish{}
+
+	tests := []struct {
+		word     string
+		expected string
+	}{
+		{"acerca", "acerc"},
+		{"chica", "chic"},
+		{"chicos", "chic"},
+		{"torniquetes", "torniquet"},
+		{"comiendo", "com"},
+		{"cantaremos", "cant"},
+		{"rápidamente", "rapid"},
+		{"nacionalidad", "nacional"},
+		{"felicidad", "felic"},
+		{"organización", "organiz"},
+		{"abogados", "abog"},
+		{"pudiéramos", "pud"},
+		{"averiguemos", "averig"},
+		{"lógica", "logic"},
+		{"biología", "biolog"},
+	}
+
+	for _, test := range tests {
+		v := s.Stem(test.word)
+		if v != test.expected {
+			t.Errorf("Error: Stem on '%v' returned wrong value, got: %v, expected: %v\n", test.word, v, test.expected)
+		}
+	}
+}
@@ -18,20 +18,118 @@ that'll,that's,there's,they'd,they'll,they're,they've,wasn't,we'd,we'll,we're,we
 what's,when'd,when'll,when's,where'd,where'll,where's,who'd,who'll,who's,why'd,why'll,why's,
 won't,would've,wouldn't,you'd,you'll,you're,you've`
 
-var stopWords map[string]bool
+// French stop words, from the Snowball French stop word list
+const frenchStopWordsText = `au,aux,avec,ce,ces,dans,de,des,du,elle,en,et,eux,il,je,la,le,leur,lui,ma,
+mais,me,même,mes,moi,mon,ne,nos,notre,nous,on,ou,par,pas,pour,qu,que,qui,sa,se,ses,son,sur,ta,
+te,tes,toi,ton,tu,un,une,vos,votre,vous,c,d,j,l,à,m,n,s,t,y,été,étée,étées,étés,étant,suis,es,
+est,sommes,êtes,sont,serai,seras,sera,serons,serez,seront,serais,serait,serions,seriez,seraient,
+étais,était,étions,étiez,étaient,fus,fut,fûmes,fûtes,furent,sois,soit,soyons,soyez,soient,fusse,
+fusses,fût,fussions,fussiez,fussent,ayant,eu,eue,eues,eus,ai,as,avons,avez,ont,aurai,auras,aura,
+aurons,aurez,auront,aurais,aurait,aurions,auriez,auraient,avais,avait,avions,aviez,avaient,eut,
+eûmes,eûtes,eurent,aie,aies,ait,ayons,ayez,aient,eusse,eusses,eût,eussions,eussiez,eussent,ceci,
+cela,celà,cet,cette,ici,ils,les,leurs,quel,quels,quelle,quelles,sans,soi`
+
+// German stop words, from the Snowball German stop word list
+const germanStopWordsText = `aber,alle,allem,allen,aller,alles,als,also,am,an,ander,andere,anderem,
+anderen,anderer,anderes,anderm,andern,anderr,anders,auch,auf,aus,bei,bin,bis,bist,da,damit,dann,
+der,den,des,dem,die,das,dass,daß,derselbe,derselben,denselben,desselben,demselben,dieselbe,
+dieselben,dasselbe,dazu,dein,deine,deinem,deinen,deiner,deines,denn,derer,dessen,dich,dir,du,
+dies,diese,diesem,diesen,dieser,dieses,doch,dort,durch,ein,eine,einem,einen,einer,eines,einig,
+einige,einigem,einigen,einiger,einiges,einmal,er,ihn,ihm,es,etwas,euer,eure,eurem,euren,eurer,
+eures,für,gegen,gewesen,hab,habe,haben,hat,hatte,hatten,hier,hin,hinter,ich,mich,mir,ihr,ihre,
+ihrem,ihren,ihrer,ihres,euch,im,in,indem,ins,ist,jede,jedem,jeden,jeder,jedes,jene,jenem,jenen,
+jener,jenes,jetzt,kann,kein,keine,keinem,keinen,keiner,keines,können,könnte,machen,man,manche,
+manchem,manchen,mancher,manches,mein,meine,meinem,meinen,meiner,meines,mit,muss,musste,nach,
+nicht,nichts,noch,nun,nur,ob,oder,ohne,sehr,sein,seine,seinem,seinen,seiner,seines,selbst,sich,
+sie,ihnen,sind,so,solche,solchem,solchen,solcher,solches,soll,sollte,sondern,sonst,über,um,und,
+uns,unsere,unserem,unseren,unser,unseres,unter,viel,vom,von,vor,während,war,waren,warst,was,weg,
+weil,weiter,welche,welchem,welchen,welcher,welches,wenn,werde,werden,wie,wieder,will,wir,wird,
+wirst,wo,wollen,wollte,würde,würden,zu,zum,zur,zwar,zwischen`
+
+// Spanish stop words, from the Snowball Spanish stop word list
+const spanishStopWordsText = `de,la,que,el,en,y,a,los,del,se,las,por,un,para,con,no,una,su,al,lo,como,
+más,pero,sus,le,ya,o,este,sí,porque,esta,entre,cuando,muy,sin,sobre,también,me,hasta,hay,donde,
+quien,desde,todo,nos,durante,todos,uno,les,ni,contra,otros,ese,eso,ante,ellos,e,esto,mí,antes,
+algunos,qué,unos,yo,otro,otras,otra,él,tanto,esa,estos,mucho,quienes,nada,muchos,cual,poco,ella,
+estar,estas,algunas,algo,nosotros,mi,mis,tú,te,ti,tu,tus,ellas,nosotras,vosotros,vosotras,os,mío,
+mía,míos,mías,tuyo,tuya,tuyos,tuyas,suyo,suya,suyos,suyas,nuestro,nuestra,nuestros,nuestras,
+vuestro,vuestra,vuestros,vuestras,esos,esas,estoy,estás,está,estamos,estáis,están,esté,estés,
+estemos,estéis,estén,estaba,estabas,estábamos,estaban,he,has,ha,hemos,habéis,han,haya,había,
+habían,soy,eres,es,somos,sois,son,sea,era,eras,éramos,eran,fue,fueron,tengo,tienes,tiene,tenemos,
+tienen,tenía,tenían`
+
+// Dutch stop words, from the Snowball Dutch stop word list
+const dutchStopWordsText = `de,en,van,ik,te,dat,die,in,een,hij,het,niet,zijn,is,was,op,aan,met,als,
+voor,had,er,maar,om,hem,dan,zou,of,wat,mijn,men,dit,zo,door,over,ze,zich,bij,ook,tot,je,mij,uit,
+der,daar,haar,naar,heb,hoe,heeft,hebben,deze,u,want,nog,zal,me,zij,nu,ge,geen,omdat,iets,worden,
+toch,al,waren,veel,meer,doen,toen,moet,ben,zonder,kan,hun,dus,alles,onder,ja,eens,hier,wie,werd,
+altijd,doch,wordt,wezen,kunnen,ons,zelf,tegen,na,reeds,wil,kon,niets,uw,iemand,geweest,andere`
+
+var (
+	stopWords map[string]bool
+
+	// stop word lists by language, used by the `stop_<language>` token filters
+	languageStopWords = map[string]map[string]bool{}
+)
 
 func init() {
-	words := strings.FieldsFunc(stopWordsText, func(r rune) bool {
+	stopWords = parseStopWords(stopWordsText)
+
+	languageStopWords["english"] = stopWords
+	languageStopWords["french"] = parseStopWords(frenchStopWordsText)
+	languageStopWords["german"] = parseStopWords(germanStopWordsText)
+	languageStopWords["spanish"] = parseStopWords(spanishStopWordsText)
+	languageStopWords["dutch"] = parseStopWords(dutchStopWordsText)
+}
+
+func parseStopWords(text string) map[string]bool {
+	words := strings.FieldsFunc(text, func(r rune) bool {
 		return r == '\n' || r == ','
 	})
 
-	stopWords = map[string]bool{}
+	m := map[string]bool{}
 	for _, word := range words {
-		stopWords[word] = true
+		m[word] = true
 	}
+	return m
 }
 
-func isStopWord(s string) bool {
-	_, ok := stopWords[s]
-	return ok
+// applyStopWords replaces the stop filters of `a` with the collections own
+// list, or removes them if stop words are disabled. Only text analyzers have
+// stop filters, analyzers without one, eg: keyword, code or ngram, are left
+// as they are. Stop words are still indexed, they are only left out of
+// queries.
+func (settings Settings) applyStopWords(a *Analyzer) {
+	if !settings.DisableStopWords && settings.StopWords == nil {
+		return
+	}
+
+	// the custom list goes where the first stop filter was, before stemming
+	i := -1
+	filters := make([]TokenFilter, 0, len(a.Filters))
+	for _, f := range a.Filters {
+		if _, ok := f.(stopFilter); !ok {
+			filters = append(filters, f)
+		} else if i < 0 {
+			i = len(filters)
+		}
+	}
+	if i < 0 {
+		return
+	}
+
+	if !settings.DisableStopWords {
+		// custom words are normalized the same way as the text they match
+		words := map[string]bool{}
+		for _, w := range settings.StopWords {
+			for _, f := range a.CharFilters {
+				w = f.Filter(w)
+			}
+			words[strings.TrimSpace(w)] = true
+		}
+
+		filters = append(filters[:i], append([]TokenFilter{stopFilter{words}}, filters[i:]...)...)
+	}
+
+	a.Filters = filters
 }
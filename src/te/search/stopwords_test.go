@@ -0,0 +1,114 @@
+package search
+
+import (
+	"testing"
+)
+
+func stopWordDocs(s *SearchEngine) {
+	docs := map[string]string{
+		"1": "To be or not to be, that is the question",
+		"2": "The Who played a concert",
+		"3": "Who wrote the question",
+	}
+	for id, body := range docs {
+		s.Index(Document{Id: id, Fields: map[string]*Field{"body": &Field{Value: body}}})
+	}
+}
+
+func TestStopWordQueries(t *testing.T) {
+	s := NewSearchEngine()
+	stopWordDocs(s)
+
+	res := s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Terms: "to be or not to be", Phrase: true}}}})
+	if res.Hits != 1 || res.Documents[0].Id != "1" {
+		t.Errorf("Expected a phrase of stop words to match, got: %v", res.Documents)
+	}
+
+	res = s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Terms: "the who", Phrase: true}}}})
+	if res.Hits != 1 || res.Documents[0].Id != "2" {
+		t.Errorf("Expected `the who` to match as a phrase, got: %v", res.Documents)
+	}
+
+	// quoted terms are phrases, stop words included
+	if res = s.Query(Query{Terms: "\"the who\""}); res.Hits != 1 || res.Documents[0].Id != "2" {
+		t.Errorf("Expected the quoted terms to match as a phrase, got: %v", res.Documents)
+	}
+	if res = s.Query(Query{Terms: "\"wrote the question\""}); res.Hits != 1 || res.Documents[0].Id != "3" {
+		t.Errorf("Expected a phrase with a stop word in it to match, got: %v", res.Documents)
+	}
+	if res = s.Query(Query{Terms: "\"wrote question\""}); res.Hits != 0 {
+		t.Errorf("Expected the stop word's position to be kept, got: %v", res.Documents)
+	}
+	if res = s.Query(Query{Terms: "concert \"the who\""}); res.Hits != 1 || res.Documents[0].Id != "2" {
+		t.Errorf("Expected the phrase and the terms to match, got: %v", res.Documents)
+	}
+	if res = s.Query(Query{Terms: "question \"the who\""}); res.Hits != 0 {
+		t.Errorf("Expected the phrase to be required, got: %v", res.Documents)
+	}
+
+	// stop words are searched for if the query has nothing else
+	if res = s.Query(Query{Terms: "the who"}); res.Hits != 3 {
+		t.Errorf("Expected a query of only stop words to match, got: %v", res.Hits)
+	}
+
+	// otherwise they are removed
+	if res = s.Query(Query{Terms: "the concert"}); res.Hits != 1 {
+		t.Errorf("Expected stop words to be removed, got: %v", res.Hits)
+	}
+}
+
+func TestCustomStopWords(t *testing.T) {
+	s := NewSearchEngine()
+	stopWordDocs(s)
+
+	if err := s.SetSettings(Settings{StopWords: []string{"Question"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if res := s.Query(Query{Terms: "question concert"}); res.Hits != 1 {
+		t.Errorf("Expected the custom stop word to be removed, got: %v", res.Hits)
+	}
+	// the default list no longer applies
+	if res := s.Query(Query{Terms: "the concert"}); res.Hits != 3 {
+		t.Errorf("Expected `the` to be searched for, got: %v", res.Hits)
+	}
+
+	s.SetSettings(Settings{DisableStopWords: true})
+	if res := s.Query(Query{Terms: "question concert"}); res.Hits != 3 {
+		t.Errorf("Expected stop words to be disabled, got: %v", res.Hits)
+	}
+
+	// analyzers with their own stop list are overridden too
+	s.SetSettings(Settings{Analyzer: "french", StopWords: []string{"chat"}})
+	terms := s.defaultAnalyzer.Analyze("le chat")
+	if len(terms) != 2 || terms[0].Stop || !terms[1].Stop {
+		t.Errorf("Expected only the custom stop word to be marked, got: %v", terms)
+	}
+}
+
+func TestStopWordsOnlyInTextAnalyzers(t *testing.T) {
+	s := NewSearchEngine()
+	err := s.SetSettings(Settings{
+		StopWords: []string{"the", "for"},
+		Fields: map[string]FieldSettings{
+			"sku":  {Analyzer: "keyword"},
+			"code": {Analyzer: "code"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Index(Document{Id: "1", Fields: map[string]*Field{
+		"sku":  &Field{Value: "The"},
+		"code": &Field{Value: "for i := range the"},
+	}})
+
+	for _, field := range []string{"sku", "code"} {
+		if terms := s.fieldAnalyzers[field].Analyze("the"); len(terms) != 1 || terms[0].Stop {
+			t.Errorf("Expected no stop words in %v, got: %v", field, terms)
+		}
+	}
+	if s.QueryField("sku", "the").Hits != 1 || s.Query(Query{Terms: "the range"}).Hits != 1 {
+		t.Errorf("Expected the stop words to be searched for outside of text analyzers")
+	}
+}
@@ -0,0 +1,183 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// SynonymRule makes query terms match other terms. Without `From` every
+	// phrase in `Terms` is equivalent, eg: `tv`, `television`. With `From`, the
+	// phrases in `From` match `Terms` but not the other way around, eg: `nyc`
+	// matches `new york city`. Phrases can be multiple words.
+	SynonymRule struct {
+		From  []string `json:"from,omitempty"`
+		Terms []string `json:"terms"`
+	}
+
+	// synonyms compiled for one analyzer, keyed by the first token of the
+	// phrase being replaced
+	synonymTable map[string][]*synonymEntry
+
+	synonymEntry struct {
+		source  []string
+		targets [][]Term
+	}
+)
+
+// synonyms match with a lower score than the terms searched for
+const synonymWeight float64 = 0.9
+
+// ParseSynonyms parses synonym rules, one per line. Equivalent phrases are
+// separated by commas, one-way rules use `=>`. Eg:
+//
+//	tv, television
+//	nyc => new york city, new york
+func ParseSynonyms(text string) ([]SynonymRule, error) {
+	rules := []SynonymRule{}
+
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var rule SynonymRule
+		parts := strings.Split(line, "=>")
+		switch len(parts) {
+		case 1:
+			rule.Terms = splitPhrases(parts[0])
+		case 2:
+			rule.From = splitPhrases(parts[0])
+			rule.Terms = splitPhrases(parts[1])
+		default:
+			return nil, fmt.Errorf("%w: line %v: only one => is allowed", ErrInvalidSettings, i+1)
+		}
+
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("%w: line %v", err, i+1)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func splitPhrases(text string) []string {
+	list := []string{}
+	for _, p := range strings.Split(text, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+func (r SynonymRule) validate() error {
+	if len(r.From) == 0 && len(r.Terms) < 2 {
+		return fmt.Errorf("%w: synonyms need at least two terms", ErrInvalidSettings)
+	}
+	if len(r.From) > 0 && len(r.Terms) == 0 {
+		return fmt.Errorf("%w: synonyms need at least one term to map to", ErrInvalidSettings)
+	}
+	return nil
+}
+
+// compileSynonyms analyzes every phrase in `rules` with `a`
+func compileSynonyms(rules []SynonymRule, a *Analyzer) synonymTable {
+	table := synonymTable{}
+	entries := map[string]*synonymEntry{}
+
+	add := func(source []Term, targets [][]Term) {
+		if len(source) == 0 {
+			return
+		}
+
+		key := termsKey(source)
+		e, ok := entries[key]
+		if !ok {
+			e = &synonymEntry{source: strings.Split(key, " ")}
+			entries[key] = e
+			table[source[0].Value] = append(table[source[0].Value], e)
+		}
+
+		for _, t := range targets {
+			if len(t) > 0 && termsKey(t) != key {
+				e.targets = append(e.targets, t)
+			}
+		}
+	}
+
+	for _, r := range rules {
+		phrases := make([][]Term, 0, len(r.Terms))
+		for _, p := range r.Terms {
+			phrases = append(phrases, a.Analyze(p))
+		}
+
+		if len(r.From) == 0 {
+			for _, p := range phrases {
+				add(p, phrases)
+			}
+			continue
+		}
+
+		for _, f := range r.From {
+			add(a.Analyze(f), phrases)
+		}
+	}
+
+	return table
+}
+
+// match returns the longest synonym phrase `terms` starts with, and its length
+func (table synonymTable) match(terms []Term) (*synonymEntry, int) {
+	var best *synonymEntry
+
+	for _, e := range table[terms[0].Value] {
+		if len(e.source) > len(terms) || (best != nil && len(e.source) <= len(best.source)) {
+			continue
+		}
+
+		matched := true
+		for i, v := range e.source {
+			if terms[i].Value != v {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			best = e
+		}
+	}
+
+	if best == nil {
+		return nil, 0
+	}
+	return best, len(best.source)
+}
+
+func termsKey(terms []Term) string {
+	values := make([]string, len(terms))
+	for i, t := range terms {
+		values[i] = t.Value
+	}
+	return strings.Join(values, " ")
+}
+
+// Synonyms returns the collections synonym rules
+func (s *SearchEngine) Synonyms() []SynonymRule {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return append([]SynonymRule{}, s.settings.Synonyms...)
+}
+
+// SetSynonyms replaces the collections synonym rules. They apply to queries
+// immediately, documents don't need to be re-indexed.
+func (s *SearchEngine) SetSynonyms(rules []SynonymRule) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	settings := s.settings.copy()
+	settings.Synonyms = append([]SynonymRule{}, rules...)
+	return s.applySettings(settings, true)
+}
@@ -0,0 +1,122 @@
+package search
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func synonymDocs(s *SearchEngine) {
+	docs := map[string]string{
+		"1": "Cheap television sets",
+		"2": "Repair your tv at home",
+		"3": "Hotels in New York City",
+		"4": "NYC subway map",
+	}
+	for id, body := range docs {
+		s.Index(Document{Id: id, Fields: map[string]*Field{"body": &Field{Value: body}}})
+	}
+}
+
+func TestSynonyms(t *testing.T) {
+	s := NewSearchEngine()
+	synonymDocs(s)
+
+	err := s.SetSynonyms([]SynonymRule{
+		{Terms: []string{"tv", "television"}},
+		{From: []string{"nyc"}, Terms: []string{"new york city"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		// equivalent terms match each other, the term searched for ranks first
+		{"tv", []string{"2", "1"}},
+		{"television", []string{"1", "2"}},
+		// one-way rules expand to a phrase
+		{"nyc", []string{"4", "3"}},
+		{"new york city", []string{"3"}},
+	}
+
+	for _, test := range tests {
+		res := s.Query(Query{Terms: test.query})
+		if len(res.Documents) != len(test.expected) {
+			t.Errorf("Expected %v for %q, got: %v", test.expected, test.query, res.Documents)
+			continue
+		}
+		for i, id := range test.expected {
+			if res.Documents[i].Id != id {
+				t.Errorf("Expected %v for %q, got: %v", test.expected, test.query, res.Documents)
+			}
+		}
+	}
+
+	if res := s.QueryField("body", "tv"); res.Hits != 2 {
+		t.Errorf("Expected synonyms to apply to field queries, got: %v", res.Hits)
+	}
+
+	res := s.Query(Query{Terms: "tv", Highlight: &Highlight{}})
+	if res.Documents[1].Highlights["body"] != "Cheap <em>television</em> sets" {
+		t.Errorf("Expected synonyms to be highlighted, got: %v", res.Documents[1].Highlights)
+	}
+
+	err = s.SetSynonyms([]SynonymRule{{Terms: []string{"tv"}}})
+	if !errors.Is(err, ErrInvalidSettings) {
+		t.Errorf("Expected ErrInvalidSettings, got: %v", err)
+	}
+	if len(s.Synonyms()) != 2 {
+		t.Errorf("Expected invalid synonyms not to be applied")
+	}
+
+	s.SetSynonyms(nil)
+	if res := s.Query(Query{Terms: "tv"}); res.Hits != 1 {
+		t.Errorf("Expected synonyms to be removed, got: %v", res.Hits)
+	}
+}
+
+func TestSynonymsPersistence(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "te_search")
+	defer os.RemoveAll(dir)
+
+	s, _ := NewPersistentSearchEngine(dir)
+	synonymDocs(s)
+	s.SetSynonyms([]SynonymRule{{Terms: []string{"tv", "television"}}})
+
+	s, err := NewPersistentSearchEngine(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := s.Query(Query{Terms: "tv"}); res.Hits != 2 {
+		t.Errorf("Expected synonyms to be restored, got: %v", res.Hits)
+	}
+}
+
+func TestParseSynonyms(t *testing.T) {
+	rules, err := ParseSynonyms(`
+		# comments and blank lines are ignored
+		tv, television
+
+		nyc => new york city, new york
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 2 || len(rules[0].Terms) != 2 || len(rules[0].From) != 0 {
+		t.Fatalf("Unexpected rules: %+v", rules)
+	}
+	if rules[1].From[0] != "nyc" || rules[1].Terms[1] != "new york" {
+		t.Errorf("Unexpected rule: %+v", rules[1])
+	}
+
+	for _, text := range []string{"tv", "a => b => c", "a =>"} {
+		if _, err := ParseSynonyms(text); !errors.Is(err, ErrInvalidSettings) {
+			t.Errorf("Expected ErrInvalidSettings for %q, got: %v", text, err)
+		}
+	}
+}
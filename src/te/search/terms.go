@@ -0,0 +1,67 @@
+package search
+
+import (
+	"sort"
+)
+
+type (
+	// TermsQuery selects a page of the term dictionary, see SearchEngine.Terms
+	TermsQuery struct {
+		// only terms starting with the prefix
+		Prefix   string
+		PageSize int
+		Page     int
+		// sort by frequency, most frequent first, rather than alphabetically.
+		// The first page is the top PageSize terms.
+		ByFrequency bool
+	}
+
+	TermsResult struct {
+		// terms matching the query, on all pages
+		Hits     int        `json:"hits"`
+		Page     int        `json:"page"`
+		PageSize int        `json:"pageSize"`
+		Terms    []TermInfo `json:"terms"`
+	}
+
+	TermInfo struct {
+		Term Token `json:"term"`
+		// times the term appears in all documents
+		Frequency int `json:"frequency"`
+		// number of documents the term is in
+		DocFrequency int `json:"docFrequency"`
+	}
+)
+
+// Terms returns a page of the tokens in the index, with how often they
+// appear. Tokens are the analyzed form of words, eg: `run` for `running`.
+func (s *SearchEngine) Terms(query TermsQuery) TermsResult {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = DefaultPageSize
+	}
+
+	terms := s.index.Terms(query.Prefix)
+	if query.ByFrequency {
+		sort.SliceStable(terms, func(a, b int) bool { return terms[a].Frequency > terms[b].Frequency })
+	}
+
+	res := TermsResult{Hits: len(terms), Page: query.Page, PageSize: query.PageSize, Terms: []TermInfo{}}
+
+	start := (query.Page - 1) * query.PageSize
+	if start >= len(terms) {
+		return res
+	}
+	end := start + query.PageSize
+	if end > len(terms) {
+		end = len(terms)
+	}
+
+	res.Terms = terms[start:end]
+	return res
+}
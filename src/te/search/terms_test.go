@@ -0,0 +1,43 @@
+package search
+
+import (
+	"testing"
+)
+
+func TestTerms(t *testing.T) {
+	s := NewSearchEngine()
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "cat cat dog"}}})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"title": &Field{Value: "cat category dogs"}}})
+	s.Index(Document{Id: "3", Fields: map[string]*Field{"title": &Field{Value: "bird"}}})
+
+	res := s.Terms(TermsQuery{})
+	if res.Hits != 4 || len(res.Terms) != 4 || res.Terms[0].Term != "bird" || res.Terms[3].Term != "dog" {
+		t.Errorf("Expected sorted terms, got: %v", res.Terms)
+	}
+	if cat := res.Terms[1]; cat.Term != "cat" || cat.Frequency != 3 || cat.DocFrequency != 2 {
+		t.Errorf("Unexpected frequencies: %v", cat)
+	}
+
+	res = s.Terms(TermsQuery{Prefix: "ca"})
+	if res.Hits != 2 || res.Terms[1].Term != "categori" {
+		t.Errorf("Expected prefix matches, got: %v", res.Terms)
+	}
+
+	res = s.Terms(TermsQuery{PageSize: 3, Page: 2})
+	if res.Hits != 4 || len(res.Terms) != 1 || res.Terms[0].Term != "dog" {
+		t.Errorf("Expected the second page, got: %v", res.Terms)
+	}
+
+	res = s.Terms(TermsQuery{PageSize: 2, ByFrequency: true})
+	if len(res.Terms) != 2 || res.Terms[0].Term != "cat" || res.Terms[1].Term != "dog" {
+		t.Errorf("Expected the most frequent terms, got: %v", res.Terms)
+	}
+
+	// terms no document contains are left out
+	s.Remove("3")
+	s.index.Add("bird", 4, []int{1})
+	s.index.Remove("bird", 4)
+	if res = s.Terms(TermsQuery{Prefix: "b"}); res.Hits != 0 || len(res.Terms) != 0 {
+		t.Errorf("Expected no terms, got: %v", res.Terms)
+	}
+}
@@ -3,6 +3,7 @@ package search
 import (
 	"strings"
 	"te/search/stemmers"
+	"unicode"
 )
 
 type (
@@ -18,90 +19,231 @@ type (
 		IsStopWord(word string) bool
 	}
 
+	// SimpleTokenizer is the standard analyzer behind the Tokenizer interface.
+	//
+	// Deprecated: use NewStandardAnalyzer, or NewAnalyzer for another analyzer.
 	SimpleTokenizer struct {
-		stemmer stemmers.PorterStemmerEnglish
+		analyzer *Analyzer
+		stemmer  stemmers.PorterStemmerEnglish
 	}
+
+	// Term is a single token produced by an analyzer
+	Term struct {
+		// the token that gets indexed
+		Value string
+		// the word the term was produced from, before token filters changed it.
+		// Eg: the term `run` may come from the word `running`. Used for partial matching.
+		Word string
+		// position in the text, starting at 1. Terms can share a position, eg:
+		// `turbo-snail` and `turbo`
+		Position int
+		// stop words are indexed, but stripped from most queries
+		Stop bool
+	}
+
+	// WordTokenizer is the tokenizer stage of an analyzer, it splits text into terms
+	WordTokenizer interface {
+		Split(text string) []Term
+	}
+
+	// standardTokenizer splits on whitespace and normalizes punctuation, see cleanWord
+	standardTokenizer struct{}
+
+	// whitespaceTokenizer splits on whitespace only
+	whitespaceTokenizer struct{}
+
+	// keywordTokenizer emits the whole text as a single term
+	keywordTokenizer struct{}
+
+	// unicodeTokenizer splits text at Unicode word boundaries, see splitWords
+	unicodeTokenizer struct{}
+
+	// cjkTokenizer is the unicodeTokenizer, but Chinese, Japanese and Korean
+	// text is split into overlapping pairs of characters. Eg: `東京都` becomes
+	// `東京` and `京都`
+	cjkTokenizer struct{}
+
+	// codeTokenizer splits source code into identifiers, eg: `fmt.Println(x)`
+	// becomes `fmt`, `Println` and `x`
+	codeTokenizer struct{}
 )
 
+// Deprecated: use NewStandardAnalyzer.
 func NewSimpleTokenizer() SimpleTokenizer {
-	return SimpleTokenizer{stemmers.PorterStemmerEnglish{}}
+	return SimpleTokenizer{analyzer: NewStandardAnalyzer()}
 }
 
 func (t *SimpleTokenizer) Tokenize(text string, stripStopWords bool) []Token {
-	list := t.CleanAndSplit(text)
+	return t.standard().Tokenize(text, stripStopWords)
+}
 
-	seen := map[string]bool{}
-	tokens := make([]Token, len(list))
-	n := 0
+func (t *SimpleTokenizer) TokenizeWithPositions(text string, startPos int) (map[Token][]int, int) {
+	return t.standard().TokenizeWithPositions(text, startPos)
+}
 
-	for _, v := range list {
-		if v == "" {
-			continue
-		}
+func (t *SimpleTokenizer) IsStopWord(word string) bool {
+	return stopWords[word]
+}
+
+func (t *SimpleTokenizer) Stem(word string) string {
+	return t.stemmer.Stem(word)
+}
+
+// CleanAndSplit returns the words in `text` after the standard analyzer's char
+// filters and tokenizer, with possessives removed as before. Stop words are
+// kept and words are not stemmed.
+func (t *SimpleTokenizer) CleanAndSplit(text string) []string {
+	a := t.standard()
+	for _, f := range a.CharFilters {
+		text = f.Filter(text)
+	}
+
+	terms := possessiveFilter{}.Filter(a.Tokenizer.Split(text))
+	words := make([]string, len(terms))
+	for i, term := range terms {
+		words[i] = term.Value
+	}
+	return words
+}
+
+// standard returns the analyzer, a zero SimpleTokenizer has none
+func (t *SimpleTokenizer) standard() *Analyzer {
+	if t.analyzer == nil {
+		t.analyzer = NewStandardAnalyzer()
+	}
+	return t.analyzer
+}
 
-		// remove stop words
-		if stripStopWords && isStopWord(v) {
+func (t standardTokenizer) Split(text string) []Term {
+	terms := []Term{}
+	pos := 0
+
+	for _, w := range strings.Fields(text) {
+		clean, parts := cleanWord(w)
+		if clean == "" {
 			continue
 		}
 
-		// apply stemming
-		v = t.Stem(v)
+		pos++
+		terms = append(terms, Term{Value: clean, Word: clean, Position: pos})
 
-		// ignore duplicates, add to reuslts if unique
-		_, ok := seen[v]
-		if !ok {
-			seen[v] = true
-			// add token to list
-			tokens[n] = Token(v)
-			n++
+		// the parts of compound words follow on from the compound word itself,
+		// so `turbo snail` is still a phrase
+		for i, p := range parts {
+			if p != "" {
+				terms = append(terms, Term{Value: p, Word: p, Position: pos + i})
+			}
+		}
+		if len(parts) > 1 {
+			pos += len(parts) - 1
 		}
 	}
 
-	return tokens[0:n]
+	return terms
 }
 
-func (t *SimpleTokenizer) TokenizeWithPositions(text string, startPos int) (map[Token][]int, int) {
-	list := t.CleanAndSplit(text)
-	tokens := make(map[Token][]int, 0)
-	pos := startPos
+func (t whitespaceTokenizer) Split(text string) []Term {
+	words := strings.Fields(text)
+	terms := make([]Term, len(words))
+	for i, w := range words {
+		terms[i] = Term{Value: w, Word: w, Position: i + 1}
+	}
+	return terms
+}
 
-	for _, v := range list {
-		if v == "" {
+func (t keywordTokenizer) Split(text string) []Term {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return []Term{}
+	}
+	return []Term{{Value: text, Word: text, Position: 1}}
+}
+
+func (t codeTokenizer) Split(text string) []Term {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]Term, len(words))
+	for i, w := range words {
+		terms[i] = Term{Value: w, Word: w, Position: i + 1}
+	}
+	return terms
+}
+
+func (t unicodeTokenizer) Split(text string) []Term {
+	terms := []Term{}
+	pos := 0
+
+	for _, w := range splitWords(text) {
+		if !isWordLike(w) {
 			continue
 		}
 
+		// curly apostrophes are treated like straight ones, `don’t` is `don't`
+		w = strings.Replace(w, "’", "'", -1)
+
 		pos++
+		terms = append(terms, Term{Value: w, Word: w, Position: pos})
+	}
+
+	return terms
+}
 
-		// apply stemming
-		tok := Token(t.Stem(v))
+func (t cjkTokenizer) Split(text string) []Term {
+	terms := []Term{}
+	pos := 0
+	run := []rune{}
 
-		// ignore duplicates, add to reuslts if unique
-		positions, ok := tokens[tok]
-		if !ok {
-			positions = []int{pos}
-			tokens[tok] = positions
-		} else {
-			positions = append(positions, pos)
+	// emits the bigrams of the current run of cjk characters, a run of a
+	// single character is emitted as is
+	flush := func() {
+		if len(run) == 1 {
+			pos++
+			terms = append(terms, Term{Value: string(run), Word: string(run), Position: pos})
+		}
+		for i := 0; i < len(run)-1; i++ {
+			pos++
+			v := string(run[i : i+2])
+			terms = append(terms, Term{Value: v, Word: v, Position: pos})
 		}
+		run = run[:0]
 	}
 
-	return tokens, pos
-}
+	for _, w := range splitWords(text) {
+		if !isWordLike(w) {
+			flush()
+			continue
+		}
 
-func (t *SimpleTokenizer) IsStopWord(word string) bool {
-	return isStopWord(word)
-}
+		// words can mix cjk and other characters, eg: `2023年`
+		start := 0
+		rs := []rune(strings.Replace(w, "’", "'", -1))
+		for i := 0; i <= len(rs); i++ {
+			if i < len(rs) && !isCJK(rs[i]) {
+				continue
+			}
 
-func (t *SimpleTokenizer) Stem(word string) string {
-	return t.stemmer.Stem(word)
+			if i > start {
+				flush()
+				pos++
+				v := string(rs[start:i])
+				terms = append(terms, Term{Value: v, Word: v, Position: pos})
+			}
+			if i < len(rs) {
+				run = append(run, rs[i])
+			}
+			start = i + 1
+		}
+	}
+	flush()
+
+	return terms
 }
 
-func (t *SimpleTokenizer) CleanAndSplit(text string) []string {
-	text = stripHtml(text)
-	text = strings.ToLower(text)
-	list := strings.Fields(text)
-	// this must come after split because it can increase the length of list
-	return cleanPunctuation(list)
+// isCJK tests if `r` is a Han, Hiragana, Katakana or Hangul character
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 0x30FC
 }
 
 // takes a list of tokens and returns the unique values
@@ -0,0 +1,176 @@
+package search
+
+//go:generate sh -c "python3 gen_unicode_tables.py > unicode_tables.go && gofmt -w unicode_tables.go"
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// hangul syllables are composed and decomposed algorithmically
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// letters that have no decomposition, but fold to ascii letters
+var accentFolds = map[rune]string{
+	'ø': "o", 'Ø': "O", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ß': "ss",
+	'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D", 'ł': "l", 'Ł': "L", 'þ': "th",
+	'Þ': "TH", 'ħ': "h", 'Ħ': "H", 'ı': "i",
+}
+
+// normalizeNFKC returns `s` in Unicode normalization form NFKC. Eg: the
+// ligature `ﬁ` becomes `fi`, full width `ｗ` becomes `w`, and `e` followed by a
+// combining accent becomes `é`.
+func normalizeNFKC(s string) string {
+	if isASCII(s) {
+		return s
+	}
+
+	rs := make([]rune, 0, len(s))
+	for _, r := range s {
+		rs = decompose(rs, r)
+	}
+	return string(compose(reorder(rs)))
+}
+
+// foldCase applies Unicode full case folding, a lower casing meant for
+// comparisons. Eg: `Straße` becomes `strasse`, `ΣΊΣΥΦΟΣ` becomes `σίσυφοσ`.
+func foldCase(s string) string {
+	if isASCII(s) {
+		return strings.ToLower(s)
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		if f, ok := caseFolds[r]; ok {
+			b.WriteString(f)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// foldAccents removes diacritics from latin, greek and cyrillic letters. Eg:
+// `café` becomes `cafe`. Marks that are part of other scripts are kept.
+func foldAccents(s string) string {
+	if isASCII(s) {
+		return s
+	}
+
+	rs := make([]rune, 0, len(s))
+	for _, r := range s {
+		if f, ok := accentFolds[r]; ok {
+			rs = append(rs, []rune(f)...)
+			continue
+		}
+
+		for _, d := range decompose(nil, r) {
+			if !isDiacritic(d) {
+				rs = append(rs, d)
+			}
+		}
+	}
+	return string(compose(reorder(rs)))
+}
+
+// decompose appends the compatibility decomposition of `r`
+func decompose(rs []rune, r rune) []rune {
+	if d, ok := decompositions[r]; ok {
+		return append(rs, []rune(d)...)
+	}
+	return append(rs, r)
+}
+
+// reorder puts runs of combining marks in canonical order
+func reorder(rs []rune) []rune {
+	for i := 1; i < len(rs); i++ {
+		for j := i; j > 0; j-- {
+			a := combiningClasses[rs[j-1]]
+			b := combiningClasses[rs[j]]
+			if b == 0 || a <= b {
+				break
+			}
+			rs[j-1], rs[j] = rs[j], rs[j-1]
+		}
+	}
+	return rs
+}
+
+// compose canonically composes decomposed runes, in place
+func compose(rs []rune) []rune {
+	out := rs[:0]
+	starter := -1
+	var last uint8
+
+	for _, r := range rs {
+		class := combiningClasses[r]
+
+		// a mark can combine with the last starter if nothing between them blocks it
+		if starter >= 0 && (len(out)-1 == starter || (last != 0 && last < class)) {
+			if c, ok := composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+
+		if class == 0 {
+			starter = len(out)
+		}
+		last = class
+		out = append(out, r)
+	}
+	return out
+}
+
+func composePair(a rune, b rune) (rune, bool) {
+	// hangul leading and vowel jamo
+	if a >= hangulLBase && a < hangulLBase+hangulLCount && b >= hangulVBase && b < hangulVBase+hangulVCount {
+		return hangulSBase + ((a-hangulLBase)*hangulVCount+(b-hangulVBase))*hangulTCount, true
+	}
+	// hangul syllable and trailing jamo
+	if a >= hangulSBase && a < hangulSBase+hangulSCount && (a-hangulSBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount {
+		return a + (b - hangulTBase), true
+	}
+
+	c, ok := compositions[[2]rune{a, b}]
+	return c, ok
+}
+
+// isDiacritic tests if `r` is in one of the combining diacritical marks blocks
+func isDiacritic(r rune) bool {
+	return (r >= 0x0300 && r <= 0x036F) || (r >= 0x1AB0 && r <= 0x1AFF) ||
+		(r >= 0x1DC0 && r <= 0x1DFF) || (r >= 0x20D0 && r <= 0x20FF) ||
+		(r >= 0xFE20 && r <= 0xFE2F)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// isWordLike tests if a segment contains a letter or number, as opposed to
+// whitespace and punctuation
+func isWordLike(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return true
+		}
+	}
+	return false
+}
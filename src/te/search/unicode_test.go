@@ -0,0 +1,168 @@
+package search
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"The quick (“brown”) fox can’t jump 32.3 feet, right?", []string{"The", "quick", "brown", "fox", "can’t", "jump", "32.3", "feet", "right"}},
+		{"e.g. U.S.A. 1,000,000 3:30", []string{"e.g", "U.S.A", "1,000,000", "3", "30"}},
+		{"snake_case and non-breaking", []string{"snake_case", "and", "non", "breaking"}},
+		{"Привет, мир!", []string{"Привет", "мир"}},
+		{"Καλημέρα κόσμε", []string{"Καλημέρα", "κόσμε"}},
+		{"مرحبا بالعالم", []string{"مرحبا", "بالعالم"}},
+		{"שלום עולם צה\"ל", []string{"שלום", "עולם", "צה\"ל"}},
+		{"नमस्ते दुनिया", []string{"नमस्ते", "दुनिया"}},
+		{"カタカナ語", []string{"カタカナ", "語"}},
+		{"café naïve", []string{"café", "naïve"}},
+	}
+
+	for _, test := range tests {
+		words := []string{}
+		for _, w := range splitWords(test.text) {
+			if isWordLike(w) {
+				words = append(words, w)
+			}
+		}
+
+		if strings.Join(words, "|") != strings.Join(test.expected, "|") {
+			t.Errorf("splitWords(%q), expected: %v, got: %v", test.text, test.expected, words)
+		}
+		if strings.Join(splitWords(test.text), "") != test.text {
+			t.Errorf("splitWords(%q) did not keep all the text", test.text)
+		}
+	}
+}
+
+func TestUnicodeNormalization(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        func(string) string
+		text     string
+		expected string
+	}{
+		{"nfkc", normalizeNFKC, "café", "café"},
+		{"nfkc", normalizeNFKC, "ﬁne ﬂour", "fine flour"},
+		{"nfkc", normalizeNFKC, "ＦＵＬＬ　ｗｉｄｔｈ １２３", "FULL width 123"},
+		{"nfkc", normalizeNFKC, "x²", "x2"},
+		{"nfkc", normalizeNFKC, "한", "한"},
+		{"nfkc", normalizeNFKC, "ậ", "ậ"},
+		{"nfkc", normalizeNFKC, "ậ", "ậ"},
+		{"case fold", foldCase, "Straße", "strasse"},
+		{"case fold", foldCase, "ΣΊΣΥΦΟΣ σίσυφος", "σίσυφοσ σίσυφοσ"},
+		{"case fold", foldCase, "ÉCOLE", "école"},
+		{"case fold", foldCase, "Ǆemal", "ǆemal"},
+		{"accent fold", foldAccents, "café naïve résumé", "cafe naive resume"},
+		{"accent fold", foldAccents, "Ørsted Łódź Œuvre", "Orsted Lodz OEuvre"},
+		{"accent fold", foldAccents, "ὀδυσσεύς", "οδυσσευς"},
+		{"accent fold", foldAccents, "नमस्ते", "नमस्ते"},
+	}
+
+	for _, test := range tests {
+		v := test.f(test.text)
+		if v != test.expected {
+			t.Errorf("%v(%q), expected: %q, got: %q", test.name, test.text, test.expected, v)
+		}
+	}
+}
+
+func TestUnicodeAnalyzer(t *testing.T) {
+	tests := []struct {
+		analyzer string
+		text     string
+		expected []Token
+	}{
+		{"unicode", "The “Café” isn’t open", []Token{"café", "op"}},
+		{"unicode", "ＦＵＬＬ width Straße", []Token{"full", "width", "strass"}},
+		{"unicode", "the dog's bone", []Token{"dog", "bone"}},
+		{"unicode_folded", "The “Café” is closed", []Token{"cafe", "clos"}},
+		{"unicode_folded", "Crème brûlée", []Token{"creme", "brule"}},
+	}
+
+	for _, test := range tests {
+		a, err := NewAnalyzer(test.analyzer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tokens := a.Tokenize(test.text, true)
+		if len(tokens) != len(test.expected) {
+			t.Errorf("%v(%q), expected: %v, got: %v", test.analyzer, test.text, test.expected, tokens)
+			continue
+		}
+		for i, tok := range test.expected {
+			if tokens[i] != tok {
+				t.Errorf("%v(%q), expected: %v, got: %v", test.analyzer, test.text, test.expected, tokens)
+				break
+			}
+		}
+	}
+
+	s := NewSearchEngine()
+	s.SetSettings(Settings{Analyzer: "unicode_folded"})
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "Le Café"}}})
+	if s.Query(Query{Terms: "cafe"}).Hits != 1 || s.Query(Query{Terms: "CAFÉ"}).Hits != 1 {
+		t.Errorf("Expected `cafe` and `CAFÉ` to match `Café`")
+	}
+}
+
+func TestCJKTokenizer(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []Term
+	}{
+		{"東京都", []Term{{Value: "東京", Position: 1}, {Value: "京都", Position: 2}}},
+		{"日", []Term{{Value: "日", Position: 1}}},
+		{"私はTokyoに住む", []Term{
+			{Value: "私は", Position: 1}, {Value: "Tokyo", Position: 2},
+			{Value: "に住", Position: 3}, {Value: "住む", Position: 4},
+		}},
+		{"한국어 검색", []Term{{Value: "한국", Position: 1}, {Value: "국어", Position: 2}, {Value: "검색", Position: 3}}},
+		{"2023年の東京。大阪", []Term{
+			{Value: "2023", Position: 1}, {Value: "年の", Position: 2}, {Value: "の東", Position: 3},
+			{Value: "東京", Position: 4}, {Value: "大阪", Position: 5},
+		}},
+		{"カタカナ", []Term{{Value: "カタ", Position: 1}, {Value: "タカ", Position: 2}, {Value: "カナ", Position: 3}}},
+	}
+
+	for _, test := range tests {
+		terms := cjkTokenizer{}.Split(test.text)
+		if len(terms) != len(test.expected) {
+			t.Errorf("Split(%q), expected: %v, got: %v", test.text, test.expected, terms)
+			continue
+		}
+		for i, e := range test.expected {
+			if terms[i].Value != e.Value || terms[i].Position != e.Position {
+				t.Errorf("Split(%q), expected: %v, got: %v", test.text, test.expected, terms)
+				break
+			}
+		}
+	}
+}
+
+func TestCJKSearch(t *testing.T) {
+	s := NewSearchEngine()
+	s.SetSettings(Settings{Analyzer: "cjk"})
+	s.Index(Document{Id: "1", Fields: map[string]*Field{"title": &Field{Value: "東京都の天気 weather report"}}})
+	s.Index(Document{Id: "2", Fields: map[string]*Field{"title": &Field{Value: "京都の天気"}}})
+
+	res := s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Terms: "東京都", Phrase: true}}}})
+	if res.Hits != 1 || res.Documents[0].Id != "1" {
+		t.Errorf("Expected a phrase match on doc 1, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Bool: &BoolQuery{Must: []Clause{{Terms: "天気 weather", Phrase: true}}}})
+	if res.Hits != 1 {
+		t.Errorf("Expected a mixed script phrase match, got: %v", ids(res))
+	}
+
+	res = s.Query(Query{Terms: "京都"})
+	if res.Hits != 2 {
+		t.Errorf("Expected 2 hits for 京都, got: %v", ids(res))
+	}
+}
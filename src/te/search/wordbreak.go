@@ -0,0 +1,240 @@
+package search
+
+import (
+	"unicode"
+)
+
+// Word boundaries following the rules of Unicode Standard Annex #29, Unicode
+// Text Segmentation. Word_Break properties are derived from the general
+// categories and scripts in the unicode package. Without a dictionary, runs
+// of complex context scripts such as Thai are kept together as one word.
+// See: unicode.org/reports/tr29
+
+type wordBreakClass uint8
+
+const (
+	wbOther wordBreakClass = iota
+	wbCR
+	wbLF
+	wbNewline
+	wbExtend
+	wbZWJ
+	wbRegionalIndicator
+	wbFormat
+	wbKatakana
+	wbHebrewLetter
+	wbALetter
+	wbSingleQuote
+	wbDoubleQuote
+	wbMidNumLet
+	wbMidLetter
+	wbMidNum
+	wbNumeric
+	wbExtendNumLet
+	wbWSegSpace
+)
+
+// scripts written without spaces, Line_Break=Complex_Context
+var complexContextScripts = []*unicode.RangeTable{
+	unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer,
+	unicode.Tai_Le, unicode.New_Tai_Lue, unicode.Tai_Tham, unicode.Tai_Viet,
+}
+
+func wordBreakClassOf(r rune) wordBreakClass {
+	switch r {
+	case '\r':
+		return wbCR
+	case '\n':
+		return wbLF
+	case 0x0B, 0x0C, 0x85, 0x2028, 0x2029:
+		return wbNewline
+	case 0x200D:
+		return wbZWJ
+	case '\'':
+		return wbSingleQuote
+	case '"':
+		return wbDoubleQuote
+	case '.', 0x2018, 0x2019, 0x2024, 0xFE52, 0xFF07, 0xFF0E:
+		return wbMidNumLet
+	case ':', 0xB7, 0x387, 0x55F, 0x5F4, 0x2027, 0xFE13, 0xFE55, 0xFF1A:
+		return wbMidLetter
+	case ',', ';', 0x37E, 0x589, 0x60C, 0x60D, 0x66C, 0x7F8, 0x2044, 0xFE10, 0xFE14, 0xFE50, 0xFE54, 0xFF0C, 0xFF1B:
+		return wbMidNum
+	case 0x202F:
+		return wbExtendNumLet
+	case 0x30FC, 0x309B, 0x309C, 0x30A0, 0xFF70:
+		// katakana marks from the common script
+		return wbKatakana
+	}
+
+	switch {
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return wbRegionalIndicator
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) || (r >= 0x1F3FB && r <= 0x1F3FF) || r == 0x200C:
+		return wbExtend
+	case unicode.Is(unicode.Cf, r) && r != 0x200B:
+		return wbFormat
+	case unicode.Is(unicode.Katakana, r):
+		return wbKatakana
+	case unicode.Is(unicode.Hebrew, r) && unicode.IsLetter(r):
+		return wbHebrewLetter
+	case unicode.Is(unicode.Nd, r):
+		return wbNumeric
+	case unicode.Is(unicode.Pc, r):
+		return wbExtendNumLet
+	case unicode.Is(unicode.Zs, r):
+		return wbWSegSpace
+	case unicode.In(r, unicode.Ideographic, unicode.Hiragana):
+		// each ideograph is a word of its own
+		return wbOther
+	case unicode.IsLetter(r) || unicode.Is(unicode.Nl, r) || unicode.In(r, complexContextScripts...):
+		return wbALetter
+	}
+	return wbOther
+}
+
+// splitWords splits `text` at word boundaries, returning every segment. Joined
+// together the segments are the original text.
+func splitWords(text string) []string {
+	runes := []rune(text)
+	if len(runes) == 0 {
+		return nil
+	}
+
+	classes := make([]wordBreakClass, len(runes))
+	for i, r := range runes {
+		classes[i] = wordBreakClassOf(r)
+	}
+
+	segments := []string{}
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if isWordBoundary(runes, classes, i) {
+			segments = append(segments, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(segments, string(runes[start:]))
+}
+
+// isWordBoundary tests if there is a word boundary before `i`
+func isWordBoundary(runes []rune, classes []wordBreakClass, i int) bool {
+	prev := classes[i-1]
+	cur := classes[i]
+
+	// WB3 - WB3d
+	if prev == wbCR && cur == wbLF {
+		return false
+	}
+	if isNewline(prev) || isNewline(cur) {
+		return true
+	}
+	if prev == wbZWJ && isPictographic(runes[i]) {
+		return false
+	}
+	if prev == wbWSegSpace && cur == wbWSegSpace {
+		return false
+	}
+
+	// WB4, extend and format characters attach to what comes before them
+	if isIgnorable(cur) {
+		return false
+	}
+
+	p := before(classes, i-1)
+	pp := wbOther
+	if j := beforeIndex(classes, i-1); j > 0 {
+		pp = before(classes, j-1)
+	}
+	next := wbOther
+	for k := i + 1; k < len(classes); k++ {
+		if !isIgnorable(classes[k]) {
+			next = classes[k]
+			break
+		}
+	}
+
+	switch {
+	// WB5 - WB7
+	case isAHLetter(p) && isAHLetter(cur):
+		return false
+	case isAHLetter(p) && isMidLetterQ(cur) && isAHLetter(next):
+		return false
+	case isAHLetter(pp) && isMidLetterQ(p) && isAHLetter(cur):
+		return false
+	// WB7a - WB7c
+	case p == wbHebrewLetter && cur == wbSingleQuote:
+		return false
+	case p == wbHebrewLetter && cur == wbDoubleQuote && next == wbHebrewLetter:
+		return false
+	case pp == wbHebrewLetter && p == wbDoubleQuote && cur == wbHebrewLetter:
+		return false
+	// WB8 - WB12
+	case p == wbNumeric && cur == wbNumeric:
+		return false
+	case isAHLetter(p) && cur == wbNumeric:
+		return false
+	case p == wbNumeric && isAHLetter(cur):
+		return false
+	case pp == wbNumeric && isMidNumQ(p) && cur == wbNumeric:
+		return false
+	case p == wbNumeric && isMidNumQ(cur) && next == wbNumeric:
+		return false
+	// WB13 - WB13b
+	case p == wbKatakana && cur == wbKatakana:
+		return false
+	case (isAHLetter(p) || p == wbNumeric || p == wbKatakana || p == wbExtendNumLet) && cur == wbExtendNumLet:
+		return false
+	case p == wbExtendNumLet && (isAHLetter(cur) || cur == wbNumeric || cur == wbKatakana):
+		return false
+	// WB15, WB16 regional indicators pair up as flags
+	case p == wbRegionalIndicator && cur == wbRegionalIndicator:
+		n := 0
+		for j := i - 1; j >= 0 && (classes[j] == wbRegionalIndicator || isIgnorable(classes[j])); j-- {
+			if classes[j] == wbRegionalIndicator {
+				n++
+			}
+		}
+		return n%2 == 0
+	}
+
+	// WB999
+	return true
+}
+
+// before returns the class at `i`, skipping back over ignorable characters
+func before(classes []wordBreakClass, i int) wordBreakClass {
+	return classes[beforeIndex(classes, i)]
+}
+
+func beforeIndex(classes []wordBreakClass, i int) int {
+	for i > 0 && isIgnorable(classes[i]) && !isNewline(classes[i-1]) {
+		i--
+	}
+	return i
+}
+
+func isIgnorable(c wordBreakClass) bool {
+	return c == wbExtend || c == wbFormat || c == wbZWJ
+}
+
+func isNewline(c wordBreakClass) bool {
+	return c == wbCR || c == wbLF || c == wbNewline
+}
+
+func isAHLetter(c wordBreakClass) bool {
+	return c == wbALetter || c == wbHebrewLetter
+}
+
+func isMidLetterQ(c wordBreakClass) bool {
+	return c == wbMidLetter || c == wbMidNumLet || c == wbSingleQuote
+}
+
+func isMidNumQ(c wordBreakClass) bool {
+	return c == wbMidNum || c == wbMidNumLet || c == wbSingleQuote
+}
+
+// isPictographic approximates Extended_Pictographic, emoji and other pictographs
+func isPictographic(r rune) bool {
+	return (r >= 0x1F000 && r <= 0x1FAFF) || (r >= 0x2600 && r <= 0x27BF) || r == 0x00A9 || r == 0x00AE
+}